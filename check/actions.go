@@ -0,0 +1,86 @@
+package check
+
+import (
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// IsCreated returns a *testerror.Error if the resource is not planned to be created
+func (t ThatType) IsCreated() (result *testerror.Error) {
+	defer record("IsCreated", t.ResourceName, nil, time.Now(), &result)
+	return t.hasActions("created", tfjson.Actions.Create)
+}
+
+// IsUpdatedInPlace returns a *testerror.Error if the resource is not planned to be updated in-place
+func (t ThatType) IsUpdatedInPlace() (result *testerror.Error) {
+	defer record("IsUpdatedInPlace", t.ResourceName, nil, time.Now(), &result)
+	return t.hasActions("updated in-place", tfjson.Actions.Update)
+}
+
+// IsReplaced returns a *testerror.Error if the resource is not planned to be replaced.
+// Both destroy-before-create and create-before-destroy replacements are accepted.
+func (t ThatType) IsReplaced() (result *testerror.Error) {
+	defer record("IsReplaced", t.ResourceName, nil, time.Now(), &result)
+	return t.hasActions("replaced", tfjson.Actions.Replace)
+}
+
+// IsNotReplaced returns a *testerror.Error if the resource is planned to be replaced.
+// A resource that is not present in the plan changes is not considered to be replaced.
+func (t ThatType) IsNotReplaced() (result *testerror.Error) {
+	defer record("IsNotReplaced", t.ResourceName, nil, time.Now(), &result)
+	rc, ok := t.Plan.ResourceChangesMap[t.ResourceName]
+	if !ok || rc.Change == nil {
+		return nil
+	}
+	if rc.Change.Actions.Replace() {
+		return testerror.Newf(
+			"%s: expected resource not to be replaced, got actions %v",
+			t.ResourceName,
+			rc.Change.Actions,
+		).WithKind(testerror.ValueMismatch).WithReference(t.ResourceName).WithValues(nil, rc.Change.Actions)
+	}
+	return nil
+}
+
+// IsDeleted returns a *testerror.Error if the resource is not planned to be deleted
+func (t ThatType) IsDeleted() (result *testerror.Error) {
+	defer record("IsDeleted", t.ResourceName, nil, time.Now(), &result)
+	return t.hasActions("deleted", tfjson.Actions.Delete)
+}
+
+// IsNoOp returns a *testerror.Error if the resource is planned to be changed in any way
+func (t ThatType) IsNoOp() (result *testerror.Error) {
+	defer record("IsNoOp", t.ResourceName, nil, time.Now(), &result)
+	return t.hasActions("unchanged", tfjson.Actions.NoOp)
+}
+
+// resourceChange returns the planned change for the resource, or a *testerror.Error if it is not in the plan
+func (t ThatType) resourceChange() (*tfjson.Change, *testerror.Error) {
+	rc, ok := t.Plan.ResourceChangesMap[t.ResourceName]
+	if !ok || rc.Change == nil {
+		return nil, testerror.Newf(
+			"%s: resource not found in plan changes",
+			t.ResourceName,
+		).WithKind(testerror.NotFound).WithReference(t.ResourceName)
+	}
+	return rc.Change, nil
+}
+
+// hasActions checks the planned actions of the resource using the supplied tfjson.Actions predicate
+func (t ThatType) hasActions(desc string, pred func(tfjson.Actions) bool) *testerror.Error {
+	c, err := t.resourceChange()
+	if err != nil {
+		return err
+	}
+	if !pred(c.Actions) {
+		return testerror.Newf(
+			"%s: expected resource to be %s, got actions %v",
+			t.ResourceName,
+			desc,
+			c.Actions,
+		).WithKind(testerror.ValueMismatch).WithReference(t.ResourceName).WithValues(desc, c.Actions)
+	}
+	return nil
+}
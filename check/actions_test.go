@@ -0,0 +1,84 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActions(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangesPlanType()
+
+	tests := []struct {
+		name     string
+		resource string
+		f        func(ThatType) error
+		errMsg   string
+	}{
+		{"IsCreated", "test_create", func(tt ThatType) error { return tt.IsCreated().AsError() }, ""},
+		{"IsCreatedFail", "test_update", func(tt ThatType) error { return tt.IsCreated().AsError() }, "test_update: expected resource to be created, got actions [update]"},
+		{"IsUpdatedInPlace", "test_update", func(tt ThatType) error { return tt.IsUpdatedInPlace().AsError() }, ""},
+		{"IsUpdatedInPlaceFail", "test_replace", func(tt ThatType) error { return tt.IsUpdatedInPlace().AsError() }, "expected resource to be updated in-place"},
+		{"IsReplaced", "test_replace", func(tt ThatType) error { return tt.IsReplaced().AsError() }, ""},
+		{"IsReplacedCreateBeforeDestroy", "test_replace_cbd", func(tt ThatType) error { return tt.IsReplaced().AsError() }, ""},
+		{"IsReplacedFail", "test_noop", func(tt ThatType) error { return tt.IsReplaced().AsError() }, "expected resource to be replaced, got actions [no-op]"},
+		{"IsNotReplaced", "test_update", func(tt ThatType) error { return tt.IsNotReplaced().AsError() }, ""},
+		{"IsNotReplacedNotInPlan", "not_exists", func(tt ThatType) error { return tt.IsNotReplaced().AsError() }, ""},
+		{"IsNotReplacedFail", "test_replace", func(tt ThatType) error { return tt.IsNotReplaced().AsError() }, "expected resource not to be replaced"},
+		{"IsDeleted", "test_delete", func(tt ThatType) error { return tt.IsDeleted().AsError() }, ""},
+		{"IsDeletedFail", "test_create", func(tt ThatType) error { return tt.IsDeleted().AsError() }, "expected resource to be deleted"},
+		{"IsNoOp", "test_noop", func(tt ThatType) error { return tt.IsNoOp().AsError() }, ""},
+		{"IsNoOpFail", "test_delete", func(tt ThatType) error { return tt.IsNoOp().AsError() }, "expected resource to be unchanged, got actions [delete]"},
+		{"NotInPlan", "not_exists", func(tt ThatType) error { return tt.IsCreated().AsError() }, "not_exists: resource not found in plan changes"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.f(mock.That(tc.resource))
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func mockResourceChange(actions ...tfjson.Action) *tfjson.ResourceChange {
+	return &tfjson.ResourceChange{
+		Change: &tfjson.Change{
+			Actions: actions,
+		},
+	}
+}
+
+func mockChangesPlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			ResourcePlannedValuesMap: map[string]*tfjson.StateResource{},
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"test_create":      mockResourceChange(tfjson.ActionCreate),
+				"test_update":      mockResourceChange(tfjson.ActionUpdate),
+				"test_replace":     mockResourceChange(tfjson.ActionDelete, tfjson.ActionCreate),
+				"test_replace_cbd": mockResourceChange(tfjson.ActionCreate, tfjson.ActionDelete),
+				"test_delete":      mockResourceChange(tfjson.ActionDelete),
+				"test_noop":        mockResourceChange(tfjson.ActionNoop),
+			},
+		},
+	}
+}
+
+func TestActionsErrorKind(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangesPlanType()
+	assert.ErrorIs(t, mock.That("test_update").IsCreated().AsError(), testerror.ValueMismatch)
+	assert.ErrorIs(t, mock.That("not_exists").IsCreated().AsError(), testerror.NotFound)
+	assert.Regexp(t, `^actions_test\.go:\d+: test_update: expected`, mock.That("test_update").IsCreated().Error())
+}
@@ -0,0 +1,166 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/ops"
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+// ChangeType is a type which can be used for more fluent assertions on the before and after values
+// of a planned resource change.
+type ChangeType struct {
+	ResourceName string
+	Change       *tfjson.Change
+	err          *testerror.Error
+}
+
+// ChangeKeyType is a type which can be used to access the before and after values of a given key
+// in a planned resource change.
+type ChangeKeyType struct {
+	Reference string
+	change    ChangeType
+	key       string
+}
+
+// Change returns a ChangeType which can be used for assertions on the before and after values of the resource.
+func (t ThatType) Change() ChangeType {
+	c, err := t.resourceChange()
+	return ChangeType{
+		ResourceName: t.ResourceName,
+		Change:       c,
+		err:          err,
+	}
+}
+
+// Key returns a ChangeKeyType for the given top-level attribute of the resource.
+func (c ChangeType) Key(key string) ChangeKeyType {
+	return ChangeKeyType{
+		Reference: fmt.Sprintf("%s.%s", c.ResourceName, key),
+		change:    c,
+		key:       key,
+	}
+}
+
+// ChangedKeys returns the sorted top-level attributes whose value differs between before and after,
+// including attributes whose value will not be known until after apply.
+func (c ChangeType) ChangedKeys() []string {
+	if c.err != nil {
+		return nil
+	}
+	before, _ := c.Change.Before.(map[string]any)
+	after, _ := c.Change.After.(map[string]any)
+	unknown, _ := c.Change.AfterUnknown.(map[string]any)
+
+	keys := make(map[string]struct{})
+	for _, m := range []map[string]any{before, after, unknown} {
+		for k := range m {
+			keys[k] = struct{}{}
+		}
+	}
+
+	changed := make([]string, 0)
+	for k := range keys {
+		if ops.ContainsUnknown(unknown[k]) || !assert.ObjectsAreEqual(before[k], after[k]) {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// OnlyKeysChange returns a *testerror.Error if any top-level attribute other than those supplied
+// is changed by the plan.
+func (c ChangeType) OnlyKeysChange(keys ...string) (result *testerror.Error) {
+	defer record("OnlyKeysChange", c.ResourceName, keys, time.Now(), &result)
+	if c.err != nil {
+		return c.err
+	}
+	allowed := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		allowed[k] = struct{}{}
+	}
+	unexpected := make([]string, 0)
+	for _, k := range c.ChangedKeys() {
+		if _, ok := allowed[k]; !ok {
+			unexpected = append(unexpected, k)
+		}
+	}
+	if len(unexpected) > 0 {
+		return testerror.Newf(
+			"%s: unexpected changes to %s",
+			c.ResourceName,
+			strings.Join(unexpected, ", "),
+		).WithKind(testerror.ValueMismatch).WithReference(c.ResourceName).WithValues(keys, unexpected)
+	}
+	return nil
+}
+
+// Before returns an ops.Operative for the value of the key before the change.
+func (k ChangeKeyType) Before() ops.Operative {
+	ref := k.Reference + " (before)"
+	if k.change.err != nil {
+		return ops.Operative{Reference: ref}.WithError(k.change.err)
+	}
+	before, _ := k.change.Change.Before.(map[string]any)
+	actual, ok := before[k.key]
+	return ops.Operative{
+		Reference: ref,
+		Actual:    actual,
+		Exist:     ok,
+	}
+}
+
+// After returns an ops.Operative for the value of the key after the change.
+// If the value, or any part of it, will not be known until after apply, the ops.Operative is marked as unknown,
+// consistent with ChangedKeys, see ThatType.Key.
+func (k ChangeKeyType) After() ops.Operative {
+	ref := k.Reference + " (after)"
+	if k.change.err != nil {
+		return ops.Operative{Reference: ref}.WithError(k.change.err)
+	}
+	unknown, _ := k.change.Change.AfterUnknown.(map[string]any)
+	after, _ := k.change.Change.After.(map[string]any)
+	actual, ok := after[k.key]
+	return plannedOperative(ref, actual, ok, unknown[k.key])
+}
+
+// IsChanged returns a *testerror.Error if the value of the key is the same before and after the change.
+func (k ChangeKeyType) IsChanged() (result *testerror.Error) {
+	defer record("IsChanged", k.Reference, nil, time.Now(), &result)
+	if k.change.err != nil {
+		return k.change.err
+	}
+	for _, c := range k.change.ChangedKeys() {
+		if c == k.key {
+			return nil
+		}
+	}
+	return testerror.Newf("%s: expected value to change", k.Reference).
+		WithKind(testerror.ValueMismatch).
+		WithReference(k.Reference)
+}
+
+// IsUnchanged returns a *testerror.Error if the value of the key differs before and after the change.
+func (k ChangeKeyType) IsUnchanged() (result *testerror.Error) {
+	defer record("IsUnchanged", k.Reference, nil, time.Now(), &result)
+	if k.change.err != nil {
+		return k.change.err
+	}
+	for _, c := range k.change.ChangedKeys() {
+		if c == k.key {
+			return testerror.Newf(
+				"%s: expected value not to change, got %v to %v",
+				k.Reference,
+				k.Before().Actual,
+				k.After().Actual,
+			).WithKind(testerror.ValueMismatch).WithReference(k.Reference).WithValues(k.Before().Actual, k.After().Actual)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangePlanType()
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{"computed", "identity", "sku_name", "tags"}, mock.That("test_update").Change().ChangedKeys())
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{"name"}, mock.That("test_create").Change().ChangedKeys())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, mock.That("not_exists").Change().ChangedKeys())
+	})
+}
+
+func TestOnlyKeysChange(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangePlanType()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		err := mock.That("test_update").Change().OnlyKeysChange("tags", "sku_name", "computed", "identity").AsError()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		err := mock.That("test_update").Change().OnlyKeysChange("tags").AsError()
+		assert.ErrorContains(t, err, "test_update: unexpected changes to computed, identity, sku_name")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		err := mock.That("not_exists").Change().OnlyKeysChange("tags").AsError()
+		assert.ErrorContains(t, err, "not_exists: resource not found in plan changes")
+	})
+}
+
+func TestChangeKey(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangePlanType()
+
+	t.Run("BeforeAfter", func(t *testing.T) {
+		t.Parallel()
+		k := mock.That("test_update").Change().Key("sku_name")
+		assert.NoError(t, k.Before().HasValue("Standard").AsError())
+		assert.NoError(t, k.After().HasValue("Premium").AsError())
+		err := k.After().HasValue("Standard").AsError()
+		assert.ErrorContains(t, err, "test_update.sku_name (after): expected value Standard not equal to actual Premium")
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		t.Parallel()
+		k := mock.That("test_update").Change().Key("tags")
+		assert.NoError(t, k.Before().Query("env").HasValue("dev").AsError())
+		assert.NoError(t, k.After().Query("env").HasValue("prod").AsError())
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		t.Parallel()
+		k := mock.That("test_update").Change().Key("computed")
+		assert.NoError(t, k.After().IsUnknown().AsError())
+		assert.NoError(t, k.Before().HasValue("old").AsError())
+	})
+
+	t.Run("NestedUnknown", func(t *testing.T) {
+		t.Parallel()
+		c := mock.That("test_update").Change()
+		assert.Contains(t, c.ChangedKeys(), "identity")
+		k := c.Key("identity")
+		assert.NoError(t, k.After().IsUnknown().AsError())
+		assert.NoError(t, k.After().Query("0.principal_id").IsUnknown().AsError())
+		assert.NoError(t, k.After().Query("0.type").HasValue("SystemAssigned").AsError())
+		assert.NoError(t, k.IsChanged().AsError())
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		t.Parallel()
+		k := mock.That("test_create").Change().Key("name")
+		assert.NoError(t, k.Before().DoesNotExist().AsError())
+		assert.NoError(t, k.After().HasValue("test").AsError())
+	})
+
+	t.Run("IsChanged", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.That("test_update").Change().Key("sku_name").IsChanged().AsError())
+		err := mock.That("test_update").Change().Key("location").IsChanged().AsError()
+		assert.ErrorContains(t, err, "test_update.location: expected value to change")
+	})
+
+	t.Run("IsUnchanged", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.That("test_update").Change().Key("location").IsUnchanged().AsError())
+		err := mock.That("test_update").Change().Key("sku_name").IsUnchanged().AsError()
+		assert.ErrorContains(t, err, "test_update.sku_name: expected value not to change, got Standard to Premium")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		k := mock.That("not_exists").Change().Key("sku_name")
+		assert.ErrorContains(t, k.Before().Exists().AsError(), "not_exists: resource not found in plan changes")
+		assert.ErrorContains(t, k.After().HasValue("Premium").AsError(), "not_exists: resource not found in plan changes")
+		assert.ErrorContains(t, k.IsChanged().AsError(), "resource not found in plan changes")
+		assert.ErrorContains(t, k.IsUnchanged().AsError(), "resource not found in plan changes")
+	})
+}
+
+func mockChangePlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"test_update": {
+					Change: &tfjson.Change{
+						Actions: tfjson.Actions{tfjson.ActionUpdate},
+						Before: map[string]any{
+							"location": "westeurope",
+							"sku_name": "Standard",
+							"tags":     map[string]any{"env": "dev"},
+							"computed": "old",
+							"identity": []any{map[string]any{"type": "SystemAssigned", "principal_id": "abc"}},
+						},
+						After: map[string]any{
+							"location": "westeurope",
+							"sku_name": "Premium",
+							"tags":     map[string]any{"env": "prod"},
+							"identity": []any{map[string]any{"type": "SystemAssigned"}},
+						},
+						AfterUnknown: map[string]any{
+							"computed": true,
+							"identity": []any{map[string]any{"principal_id": true}},
+						},
+					},
+				},
+				"test_create": {
+					Change: &tfjson.Change{
+						Actions: tfjson.Actions{tfjson.ActionCreate},
+						After: map[string]any{
+							"name": "test",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package check
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// ChangesType is a type which can be used for more fluent assertions on the summary of changes in the Terraform plan.
+// The counts match those in the summary line printed by `terraform plan`,
+// e.g. a replacement is counted as both an add and a destroy.
+type ChangesType struct {
+	Add     int // The number of managed resources to be created, including replacements.
+	Change  int // The number of managed resources to be updated in-place.
+	Destroy int // The number of managed resources to be destroyed, including replacements.
+	Replace int // The number of managed resources to be replaced.
+	Read    int // The number of data sources to be read during apply.
+}
+
+// Changes returns a ChangesType summarising the planned actions of all resources in the plan,
+// or in the module scope if InModule has been used.
+func (p PlanType) Changes() ChangesType {
+	c := ChangesType{}
+	for addr, rc := range p.Plan.ResourceChangesMap {
+		if rc.Change == nil || !p.inScope(addr) {
+			continue
+		}
+		a := rc.Change.Actions
+		if rc.Mode == tfjson.DataResourceMode {
+			if a.Read() {
+				c.Read++
+			}
+			continue
+		}
+		switch {
+		case a.Create():
+			c.Add++
+		case a.Update():
+			c.Change++
+		case a.Delete():
+			c.Destroy++
+		case a.Replace():
+			c.Add++
+			c.Destroy++
+			c.Replace++
+		}
+	}
+	return c
+}
+
+// String returns the changes in the same format as the `terraform plan` summary line.
+func (c ChangesType) String() string {
+	return fmt.Sprintf("Plan: %d to add, %d to change, %d to destroy.", c.Add, c.Change, c.Destroy)
+}
+
+// ToAdd returns a *testerror.Error if the number of resources to add is not equal to the expected number
+func (c ChangesType) ToAdd(expected int) (result *testerror.Error) {
+	defer record("ToAdd", "plan", expected, time.Now(), &result)
+	return countEquals("to add", expected, c.Add)
+}
+
+// ToChange returns a *testerror.Error if the number of resources to change is not equal to the expected number
+func (c ChangesType) ToChange(expected int) (result *testerror.Error) {
+	defer record("ToChange", "plan", expected, time.Now(), &result)
+	return countEquals("to change", expected, c.Change)
+}
+
+// ToDestroy returns a *testerror.Error if the number of resources to destroy is not equal to the expected number
+func (c ChangesType) ToDestroy(expected int) (result *testerror.Error) {
+	defer record("ToDestroy", "plan", expected, time.Now(), &result)
+	return countEquals("to destroy", expected, c.Destroy)
+}
+
+// ToReplace returns a *testerror.Error if the number of resources to replace is not equal to the expected number
+func (c ChangesType) ToReplace(expected int) (result *testerror.Error) {
+	defer record("ToReplace", "plan", expected, time.Now(), &result)
+	return countEquals("to replace", expected, c.Replace)
+}
+
+// ToRead returns a *testerror.Error if the number of data sources to read during apply is not equal to the expected number
+func (c ChangesType) ToRead(expected int) (result *testerror.Error) {
+	defer record("ToRead", "plan", expected, time.Now(), &result)
+	return countEquals("to read", expected, c.Read)
+}
+
+// NoChanges returns a *testerror.Error if any managed resource is planned to be added, changed or destroyed
+func (c ChangesType) NoChanges() (result *testerror.Error) {
+	defer record("NoChanges", "plan", nil, time.Now(), &result)
+	if c.Add != 0 || c.Change != 0 || c.Destroy != 0 {
+		return testerror.Newf("expected no changes, got %s", c).WithKind(testerror.ValueMismatch).WithValues(nil, c)
+	}
+	return nil
+}
+
+// NoReplacements returns a *testerror.Error if any managed resource is planned to be replaced
+func (c ChangesType) NoReplacements() (result *testerror.Error) {
+	defer record("NoReplacements", "plan", nil, time.Now(), &result)
+	if c.Replace != 0 {
+		return testerror.Newf("expected no replacements, got %d to replace", c.Replace).
+			WithKind(testerror.ValueMismatch).
+			WithValues(0, c.Replace)
+	}
+	return nil
+}
+
+func countEquals(desc string, expected, actual int) *testerror.Error {
+	if actual != expected {
+		return testerror.Newf("expected %d %s, got %d", expected, desc, actual).
+			WithKind(testerror.ValueMismatch).
+			WithValues(expected, actual)
+	}
+	return nil
+}
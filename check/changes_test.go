@@ -0,0 +1,63 @@
+package check
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanges(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangesPlanType()
+	rc := mockResourceChange(tfjson.ActionRead)
+	rc.Mode = tfjson.DataResourceMode
+	mock.Plan.ResourceChangesMap["data.test_read"] = rc
+
+	c := mock.Changes()
+	assert.Equal(t, ChangesType{Add: 3, Change: 1, Destroy: 3, Replace: 2, Read: 1}, c)
+	assert.Equal(t, "Plan: 3 to add, 1 to change, 3 to destroy.", c.String())
+
+	t.Run("Counts", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, c.ToAdd(3).AsError())
+		assert.NoError(t, c.ToChange(1).AsError())
+		assert.NoError(t, c.ToDestroy(3).AsError())
+		assert.NoError(t, c.ToReplace(2).AsError())
+		assert.NoError(t, c.ToRead(1).AsError())
+	})
+
+	t.Run("CountsFail", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorContains(t, c.ToAdd(0).AsError(), "expected 0 to add, got 3")
+		assert.ErrorContains(t, c.ToChange(0).AsError(), "expected 0 to change, got 1")
+		assert.ErrorContains(t, c.ToDestroy(0).AsError(), "expected 0 to destroy, got 3")
+		assert.ErrorContains(t, c.ToReplace(0).AsError(), "expected 0 to replace, got 2")
+		assert.ErrorContains(t, c.ToRead(0).AsError(), "expected 0 to read, got 1")
+	})
+
+	t.Run("NoChangesFail", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorContains(t, c.NoChanges().AsError(), "expected no changes, got Plan: 3 to add, 1 to change, 3 to destroy.")
+	})
+
+	t.Run("NoReplacementsFail", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorContains(t, c.NoReplacements().AsError(), "expected no replacements, got 2 to replace")
+	})
+}
+
+func TestNoChanges(t *testing.T) {
+	t.Parallel()
+
+	mock := mockChangesPlanType()
+	for k, v := range mock.Plan.ResourceChangesMap {
+		if !v.Change.Actions.NoOp() {
+			delete(mock.Plan.ResourceChangesMap, k)
+		}
+	}
+	c := mock.Changes()
+	assert.NoError(t, c.NoChanges().AsError())
+	assert.NoError(t, c.NoReplacements().AsError())
+}
@@ -0,0 +1,60 @@
+package check
+
+import (
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// DataType is a type which can be used for more fluent assertions for a given data source.
+// It embeds a ThatType, so the Exists, DoesNotExist and Key methods can be used.
+type DataType struct {
+	ThatType
+}
+
+// Data returns a DataType for the given data source address, without the `data.` prefix,
+// e.g. `azurerm_client_config.current`.
+// Values of data sources read during plan are taken from the prior state.
+func (p PlanType) Data(name string) DataType {
+	return DataType{
+		ThatType: p.That("data." + name),
+	}
+}
+
+// IsReadDuringApply returns a *testerror.Error if the data source does not exist,
+// or if it is not deferred to be read during apply.
+func (d DataType) IsReadDuringApply() (result *testerror.Error) {
+	defer record("IsReadDuringApply", d.ResourceName, nil, time.Now(), &result)
+	if !d.exists() {
+		return d.notFound()
+	}
+	if !d.readDuringApply() {
+		return testerror.Newf(
+			"%s: expected data source to be read during apply",
+			d.ResourceName,
+		).WithKind(testerror.ValueMismatch).WithReference(d.ResourceName)
+	}
+	return nil
+}
+
+// IsReadDuringPlan returns a *testerror.Error if the data source does not exist,
+// or if it is deferred to be read during apply.
+func (d DataType) IsReadDuringPlan() (result *testerror.Error) {
+	defer record("IsReadDuringPlan", d.ResourceName, nil, time.Now(), &result)
+	if !d.exists() {
+		return d.notFound()
+	}
+	if d.readDuringApply() {
+		return testerror.Newf(
+			"%s: expected data source to be read during plan, got deferred read during apply",
+			d.ResourceName,
+		).WithKind(testerror.ValueMismatch).WithReference(d.ResourceName)
+	}
+	return nil
+}
+
+// readDuringApply returns true if the data source is deferred to be read during apply.
+func (d DataType) readDuringApply() bool {
+	rc, ok := d.Plan.ResourceChangesMap[d.ResourceName]
+	return ok && rc.Change != nil && rc.Change.Actions.Read()
+}
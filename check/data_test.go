@@ -0,0 +1,107 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestData(t *testing.T) {
+	t.Parallel()
+
+	mock := mockDataPlanType()
+
+	t.Run("ReadDuringPlan", func(t *testing.T) {
+		t.Parallel()
+		d := mock.Data("test_config.current")
+		assert.Equal(t, "data.test_config.current", d.ResourceName)
+		assert.NoError(t, d.Exists().AsError())
+		assert.NoError(t, d.Key("tenant_id").HasValue("00000000").AsError())
+		assert.NoError(t, d.IsReadDuringPlan().AsError())
+		err := d.IsReadDuringApply().AsError()
+		assert.ErrorContains(t, err, "data.test_config.current: expected data source to be read during apply")
+	})
+
+	t.Run("ReadDuringApply", func(t *testing.T) {
+		t.Parallel()
+		d := mock.Data("test_deferred.this")
+		assert.NoError(t, d.Exists().AsError())
+		assert.NoError(t, d.Key("id").IsUnknown().AsError())
+		assert.NoError(t, d.IsReadDuringApply().AsError())
+		err := d.IsReadDuringPlan().AsError()
+		assert.ErrorContains(t, err, "data.test_deferred.this: expected data source to be read during plan, got deferred read during apply")
+	})
+
+	t.Run("InModule", func(t *testing.T) {
+		t.Parallel()
+		d := mock.InModule("module.test").Data("test_config.current")
+		assert.NoError(t, d.Key("tenant_id").HasValue("11111111").AsError())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		d := mock.Data("not_exists.this")
+		assert.NoError(t, d.DoesNotExist().AsError())
+		assert.ErrorContains(t, d.IsReadDuringPlan().AsError(), "data.not_exists.this: resource not found in plan")
+		assert.ErrorContains(t, d.IsReadDuringApply().AsError(), "data.not_exists.this: resource not found in plan")
+	})
+
+	t.Run("ManagedNotInPriorState", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.That("test_deleted.this").DoesNotExist().AsError())
+	})
+}
+
+func mockDataPlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			RawPlan: tfjson.Plan{
+				PriorState: &tfjson.State{
+					Values: &tfjson.StateValues{
+						RootModule: &tfjson.StateModule{
+							Resources: []*tfjson.StateResource{
+								{
+									Address:         "data.test_config.current",
+									Mode:            tfjson.DataResourceMode,
+									AttributeValues: map[string]any{"tenant_id": "00000000"},
+								},
+								{
+									Address: "test_deleted.this",
+									Mode:    tfjson.ManagedResourceMode,
+								},
+							},
+							ChildModules: []*tfjson.StateModule{
+								{
+									Resources: []*tfjson.StateResource{
+										{
+											Address:         "module.test.data.test_config.current",
+											Mode:            tfjson.DataResourceMode,
+											AttributeValues: map[string]any{"tenant_id": "11111111"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ResourcePlannedValuesMap: map[string]*tfjson.StateResource{
+				"data.test_deferred.this": {
+					Mode:            tfjson.DataResourceMode,
+					AttributeValues: map[string]any{"id": nil},
+				},
+			},
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"data.test_deferred.this": {
+					Mode: tfjson.DataResourceMode,
+					Change: &tfjson.Change{
+						Actions:      tfjson.Actions{tfjson.ActionRead},
+						AfterUnknown: map[string]any{"id": true},
+					},
+				},
+			},
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package check
 
 import (
+	"strings"
+	"time"
+
 	"github.com/Azure/terratest-terraform-fluent/testerror"
 	"github.com/gruntwork-io/terratest/modules/terraform"
 )
@@ -13,15 +16,24 @@ func InPlan(plan *terraform.PlanStruct) PlanType {
 }
 
 // PlanType is a type which can be used for more fluent assertions on the Terraform plan.
+// If Module is set, resource addresses are relative to that module, see InModule.
 type PlanType struct {
-	Plan *terraform.PlanStruct
+	Plan   *terraform.PlanStruct
+	Module string
 }
 
 // NumberOfResourcesEquals checks that the number of resources in the plan is equal to the expected number.
-func (p PlanType) NumberOfResourcesEquals(expected int) *testerror.Error {
+// If the PlanType is scoped to a module, only resources within the module scope are counted, see ResourcesInModuleEquals.
+func (p PlanType) NumberOfResourcesEquals(expected int) (result *testerror.Error) {
+	if p.Module != "" {
+		return p.ResourcesInModuleEquals(expected)
+	}
+	defer record("NumberOfResourcesEquals", "plan", expected, time.Now(), &result)
 	actual := len(p.Plan.ResourcePlannedValuesMap)
 	if actual != expected {
-		return testerror.Newf("expected %d resources, got %d", expected, actual)
+		return testerror.Newf("expected %d resources, got %d", expected, actual).
+			WithKind(testerror.ValueMismatch).
+			WithValues(expected, actual)
 	}
 	return nil
 }
@@ -30,8 +42,70 @@ func (p PlanType) NumberOfResourcesEquals(expected int) *testerror.Error {
 func (p PlanType) That(resourceName string) ThatType {
 	t := ThatType{
 		Plan:         p.Plan,
-		ResourceName: resourceName,
+		ResourceName: p.address(resourceName),
 	}
 	t.exists()
 	return t
 }
+
+// InModule returns a PlanType scoped to the supplied module address, e.g. `module.network`.
+// Scopes can be nested, e.g. InModule("module.network").InModule(`module.subnet["a"]`).
+// Resource addresses passed to the returned PlanType are relative to the module.
+func (p PlanType) InModule(module string) PlanType {
+	p.Module = p.address(module)
+	return p
+}
+
+// ModuleCalled returns a *testerror.Error if the supplied module, relative to the current scope,
+// has no resources in the plan, excluding resources that are only being deleted. Any instance of a module called with count or for_each is accepted.
+func (p PlanType) ModuleCalled(module string) (result *testerror.Error) {
+	defer record("ModuleCalled", p.address(module), nil, time.Now(), &result)
+	addr := p.address(module)
+	for _, a := range p.addresses() {
+		if strings.HasPrefix(a, addr+".") || strings.HasPrefix(a, addr+"[") {
+			return nil
+		}
+	}
+	return testerror.Newf("%s: module not found in plan", addr).WithKind(testerror.NotFound).WithReference(addr)
+}
+
+// ResourcesInModuleEquals checks that the number of resources in the plan within the current module scope,
+// including any nested child modules, is equal to the expected number.
+func (p PlanType) ResourcesInModuleEquals(expected int) (result *testerror.Error) {
+	ref := p.reference()
+	defer record("ResourcesInModuleEquals", ref, expected, time.Now(), &result)
+	actual := 0
+	for a := range p.Plan.ResourcePlannedValuesMap {
+		if p.inScope(a) {
+			actual++
+		}
+	}
+	if actual != expected {
+		return testerror.Newf("%s: expected %d resources, got %d", ref, expected, actual).
+			WithKind(testerror.ValueMismatch).
+			WithReference(ref).
+			WithValues(expected, actual)
+	}
+	return nil
+}
+
+// reference returns the module scope for use in errors and reports, or `plan` if the PlanType is not scoped.
+func (p PlanType) reference() string {
+	if p.Module == "" {
+		return "plan"
+	}
+	return p.Module
+}
+
+// address returns the full address of the supplied address relative to the module scope.
+func (p PlanType) address(addr string) string {
+	if p.Module == "" {
+		return addr
+	}
+	return p.Module + "." + addr
+}
+
+// inScope returns true if the supplied full address is within the module scope.
+func (p PlanType) inScope(addr string) bool {
+	return p.Module == "" || strings.HasPrefix(addr, p.Module+".")
+}
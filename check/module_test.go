@@ -0,0 +1,101 @@
+package check
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInModule(t *testing.T) {
+	t.Parallel()
+
+	mock := mockModulePlanType()
+
+	t.Run("That", func(t *testing.T) {
+		t.Parallel()
+		tt := mock.InModule("module.network").That("test_vnet.this")
+		assert.Equal(t, "module.network.test_vnet.this", tt.ResourceName)
+		assert.NoError(t, tt.Exists().AsError())
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		t.Parallel()
+		p := mock.InModule("module.network").InModule(`module.subnet["a"]`)
+		assert.Equal(t, `module.network.module.subnet["a"]`, p.Module)
+		assert.NoError(t, p.That("test_subnet.this").Exists().AsError())
+	})
+
+	t.Run("ThatAll", func(t *testing.T) {
+		t.Parallel()
+		ta := mock.InModule("module.network").ThatAll("*")
+		assert.Equal(t, 3, ta.Count())
+		assert.Equal(t, "module.network.*", ta.Pattern)
+		ta = mock.InModule("module.network").ThatAllMatching(regexp.MustCompile(`^test_vnet\.`))
+		assert.Equal(t, []string{"module.network.test_vnet.this"}, ta.ResourceNames)
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.InModule("module.network").Changes().ToAdd(1).AsError())
+		assert.NoError(t, mock.Changes().ToAdd(2).AsError())
+	})
+}
+
+func TestModuleCalled(t *testing.T) {
+	t.Parallel()
+
+	mock := mockModulePlanType()
+	assert.NoError(t, mock.ModuleCalled("module.network").AsError())
+	assert.NoError(t, mock.ModuleCalled("module.network.module.subnet").AsError())
+	assert.NoError(t, mock.InModule("module.network").ModuleCalled(`module.subnet["b"]`).AsError())
+	err := mock.InModule("module.network").ModuleCalled("module.diag").AsError()
+	assert.ErrorContains(t, err, "module.network.module.diag: module not found in plan")
+	err = mock.ModuleCalled("module.net").AsError()
+	assert.ErrorContains(t, err, "module.net: module not found in plan")
+	err = mock.ModuleCalled("module.old").AsError()
+	assert.ErrorContains(t, err, "module.old: module not found in plan")
+}
+
+func TestResourcesInModuleEquals(t *testing.T) {
+	t.Parallel()
+
+	mock := mockModulePlanType()
+	assert.NoError(t, mock.ResourcesInModuleEquals(4).AsError())
+	assert.NoError(t, mock.InModule("module.network").ResourcesInModuleEquals(3).AsError())
+	assert.NoError(t, mock.InModule("module.network").InModule(`module.subnet["a"]`).ResourcesInModuleEquals(1).AsError())
+	err := mock.InModule("module.network").ResourcesInModuleEquals(1).AsError()
+	assert.ErrorContains(t, err, "module.network: expected 1 resources, got 3")
+	err = mock.ResourcesInModuleEquals(5).AsError()
+	assert.ErrorContains(t, err, "plan: expected 5 resources, got 4")
+}
+
+func TestNumberOfResourcesEqualsInModule(t *testing.T) {
+	t.Parallel()
+
+	mock := mockModulePlanType()
+	assert.NoError(t, mock.NumberOfResourcesEquals(4).AsError())
+	assert.NoError(t, mock.InModule("module.network").NumberOfResourcesEquals(3).AsError())
+	err := mock.InModule("module.network").NumberOfResourcesEquals(4).AsError()
+	assert.ErrorContains(t, err, "module.network: expected 4 resources, got 3")
+}
+
+func mockModulePlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			ResourcePlannedValuesMap: map[string]*tfjson.StateResource{
+				"test_rg.this":                                       {},
+				"module.network.test_vnet.this":                      {},
+				`module.network.module.subnet["a"].test_subnet.this`: {},
+				`module.network.module.subnet["b"].test_subnet.this`: {},
+			},
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"test_rg.this":                  mockResourceChange(tfjson.ActionCreate),
+				"module.network.test_vnet.this": mockResourceChange(tfjson.ActionCreate),
+				"module.old.test_rg.this":       mockResourceChange(tfjson.ActionDelete),
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// OfType returns a ThatAllType containing all managed resources of the supplied type within the module scope,
+// e.g. `azurerm_private_endpoint`.
+// Resources are matched using the type recorded in the plan, so addresses do not need to be known in advance.
+// Resources that are only being deleted by the plan are not included.
+func (p PlanType) OfType(resourceType string) ThatAllType {
+	seen := make(map[string]struct{})
+	for addr, r := range p.Plan.ResourcePlannedValuesMap {
+		if r.Mode != tfjson.DataResourceMode && r.Type == resourceType && p.inScope(addr) {
+			seen[addr] = struct{}{}
+		}
+	}
+	for addr, rc := range p.Plan.ResourceChangesMap {
+		if rc.Mode != tfjson.DataResourceMode && rc.Type == resourceType && p.inScope(addr) && !isDeleteOnly(rc.Change) {
+			seen[addr] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for addr := range seen {
+		names = append(names, addr)
+	}
+	sort.Strings(names)
+	return ThatAllType{
+		Plan:          p.Plan,
+		Pattern:       fmt.Sprintf("%s of type %s", p.address("*"), resourceType),
+		ResourceNames: names,
+	}
+}
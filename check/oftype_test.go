@@ -0,0 +1,77 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfType(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOfTypePlanType()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		ta := mock.OfType("test_pe")
+		assert.Equal(t, []string{"module.network.test_pe.that", "test_pe.this"}, ta.ResourceNames)
+		assert.NoError(t, ta.CountEquals(2).AsError())
+	})
+
+	t.Run("InModule", func(t *testing.T) {
+		t.Parallel()
+		ta := mock.InModule("module.network").OfType("test_pe")
+		assert.Equal(t, []string{"module.network.test_pe.that"}, ta.ResourceNames)
+	})
+
+	t.Run("ExcludesDeleted", func(t *testing.T) {
+		t.Parallel()
+		assert.NotContains(t, mock.OfType("test_pe").ResourceNames, "test_pe.deleted")
+	})
+
+	t.Run("ExcludesDataSources", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 0, mock.OfType("test_config").Count())
+	})
+
+	t.Run("CountEqualsFail", func(t *testing.T) {
+		t.Parallel()
+		err := mock.OfType("test_role").CountEquals(1).AsError()
+		assert.ErrorContains(t, err, "* of type test_role: expected 1 resources, got 0")
+	})
+
+	t.Run("Each", func(t *testing.T) {
+		t.Parallel()
+		err := mock.OfType("test_pe").Each(func(tt ThatType) *testerror.Error { return tt.IsCreated() }).AsError()
+		assert.ErrorContains(t, err, "1 of 2 resources failed")
+		assert.ErrorContains(t, err, "module.network.test_pe.that: resource not found in plan changes")
+	})
+}
+
+func mockOfTypePlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			ResourcePlannedValuesMap: map[string]*tfjson.StateResource{
+				"test_pe.this":                {Type: "test_pe", Mode: tfjson.ManagedResourceMode},
+				"module.network.test_pe.that": {Type: "test_pe", Mode: tfjson.ManagedResourceMode},
+				"test_rg.this":                {Type: "test_rg", Mode: tfjson.ManagedResourceMode},
+				"data.test_config.this":       {Type: "test_config", Mode: tfjson.DataResourceMode},
+			},
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"test_pe.this": {
+					Type:   "test_pe",
+					Mode:   tfjson.ManagedResourceMode,
+					Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+				},
+				"test_pe.deleted": {
+					Type:   "test_pe",
+					Mode:   tfjson.ManagedResourceMode,
+					Change: &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+				},
+			},
+		},
+	}
+}
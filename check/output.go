@@ -0,0 +1,103 @@
+package check
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/ops"
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// OutputType is a type which can be used for more fluent assertions for a given output in the plan.
+// It embeds an ops.Operative, so the planned value can be compared and queried without running apply.
+// Outputs whose value will not be known until after apply are marked as unknown in the ops.Operative.
+type OutputType struct {
+	ops.Operative
+	Sensitive bool // Whether the output is marked as sensitive.
+}
+
+// Output returns an OutputType for the given root module output name,
+// using the planned values and output changes in the plan.
+// Outputs that are being removed from the configuration are not found.
+// The plan only records root module outputs, so if the PlanType is scoped to a module the output is never found.
+func (p PlanType) Output(name string) OutputType {
+	ref := p.address(fmt.Sprintf("output.%s", name))
+	if p.Module != "" {
+		return OutputType{Operative: ops.Operative{Reference: ref}}
+	}
+	var (
+		actual, unknown  any
+		exist, sensitive bool
+	)
+	if p.Plan.RawPlan.PlannedValues != nil {
+		if out, ok := p.Plan.RawPlan.PlannedValues.Outputs[name]; ok && out != nil {
+			exist = true
+			actual = out.Value
+			sensitive = out.Sensitive
+		}
+	}
+	if c, ok := p.Plan.RawPlan.OutputChanges[name]; ok && c != nil && !isDeleteOnly(c) {
+		exist = true
+		unknown = c.AfterUnknown
+		if s, ok := c.AfterSensitive.(bool); ok && s {
+			sensitive = true
+		}
+	}
+	return OutputType{
+		Operative: plannedOperative(ref, actual, exist, unknown),
+		Sensitive: sensitive,
+	}
+}
+
+// IsSensitive returns a *testerror.Error if the output does not exist or is not marked as sensitive
+func (o OutputType) IsSensitive() (result *testerror.Error) {
+	defer record("IsSensitive", o.Reference, nil, time.Now(), &result)
+	if !o.Exist {
+		return o.notFound()
+	}
+	if !o.Sensitive {
+		return testerror.Newf("%s: expected output to be sensitive", o.Reference).
+			WithKind(testerror.ValueMismatch).
+			WithReference(o.Reference)
+	}
+	return nil
+}
+
+// IsNotSensitive returns a *testerror.Error if the output does not exist or is marked as sensitive
+func (o OutputType) IsNotSensitive() (result *testerror.Error) {
+	defer record("IsNotSensitive", o.Reference, nil, time.Now(), &result)
+	if !o.Exist {
+		return o.notFound()
+	}
+	if o.Sensitive {
+		return testerror.Newf("%s: expected output not to be sensitive", o.Reference).
+			WithKind(testerror.ValueMismatch).
+			WithReference(o.Reference)
+	}
+	return nil
+}
+
+// notFound returns the *testerror.Error for an output that does not exist in the plan.
+// It is used rather than Exists, so that only the calling check is reported.
+func (o OutputType) notFound() *testerror.Error {
+	return testerror.Newf(
+		"%s: not found when expected",
+		o.Reference,
+	).WithKind(testerror.NotFound).WithReference(o.Reference)
+}
+
+// IsUnknownUntilApply returns a *testerror.Error if the output does not exist or its value is known at plan time
+func (o OutputType) IsUnknownUntilApply() (result *testerror.Error) {
+	defer record("IsUnknownUntilApply", o.Reference, nil, time.Now(), &result)
+	if !o.Exist {
+		return o.notFound()
+	}
+	if !o.Unknown && !ops.ContainsUnknown(o.AfterUnknown) {
+		return testerror.Newf(
+			"%s: expected value to be unknown until apply, got %v",
+			o.Reference,
+			o.Actual,
+		).WithKind(testerror.ValueMismatch).WithReference(o.Reference).WithValues(nil, o.Actual)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOutputPlanType()
+
+	t.Run("HasValue", func(t *testing.T) {
+		t.Parallel()
+		o := mock.Output("test_string")
+		assert.Equal(t, "output.test_string", o.Reference)
+		assert.NoError(t, o.HasValue("test").AsError())
+		assert.NoError(t, o.IsNotSensitive().AsError())
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		t.Parallel()
+		err := mock.Output("test_map").Query("key").HasValue("value").AsError()
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		o := mock.Output("not_exists")
+		assert.ErrorContains(t, o.HasValue("test").AsError(), "output.not_exists: not found when expected")
+		assert.ErrorContains(t, o.IsSensitive().AsError(), "output.not_exists: not found when expected")
+		assert.ErrorContains(t, o.IsUnknownUntilApply().AsError(), "output.not_exists: not found when expected")
+		assert.NoError(t, o.DoesNotExist().AsError())
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		t.Parallel()
+		o := mock.Output("test_removed")
+		assert.ErrorContains(t, o.Exists().AsError(), "output.test_removed: not found when expected")
+		assert.ErrorContains(t, o.HasValue(nil).AsError(), "output.test_removed: not found when expected")
+		assert.NoError(t, o.DoesNotExist().AsError())
+	})
+
+	t.Run("InModule", func(t *testing.T) {
+		t.Parallel()
+		o := mock.InModule("module.network").Output("test_string")
+		assert.Equal(t, "module.network.output.test_string", o.Reference)
+		assert.ErrorContains(t, o.HasValue("test").AsError(), "module.network.output.test_string: not found when expected")
+	})
+
+	t.Run("IsSensitive", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.Output("test_sensitive").IsSensitive().AsError())
+		err := mock.Output("test_string").IsSensitive().AsError()
+		assert.ErrorContains(t, err, "output.test_string: expected output to be sensitive")
+		err = mock.Output("test_sensitive").IsNotSensitive().AsError()
+		assert.ErrorContains(t, err, "output.test_sensitive: expected output not to be sensitive")
+	})
+
+	t.Run("IsUnknownUntilApply", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.Output("test_unknown").IsUnknownUntilApply().AsError())
+		assert.NoError(t, mock.Output("test_partly_unknown").IsUnknownUntilApply().AsError())
+		assert.NoError(t, mock.Output("test_partly_unknown").Query("known").HasValue("value").AsError())
+		err := mock.Output("test_string").IsUnknownUntilApply().AsError()
+		assert.ErrorContains(t, err, "output.test_string: expected value to be unknown until apply, got test")
+		err = mock.Output("test_unknown").HasValue("test").AsError()
+		assert.ErrorContains(t, err, "output.test_unknown: value is unknown until apply, expected test")
+	})
+}
+
+func mockOutputPlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			RawPlan: tfjson.Plan{
+				PlannedValues: &tfjson.StateValues{
+					Outputs: map[string]*tfjson.StateOutput{
+						"test_string":         {Value: "test"},
+						"test_map":            {Value: map[string]any{"key": "value"}},
+						"test_sensitive":      {Value: "secret", Sensitive: true},
+						"test_unknown":        {},
+						"test_partly_unknown": {Value: map[string]any{"known": "value"}},
+					},
+				},
+				OutputChanges: map[string]*tfjson.Change{
+					"test_string":         {After: "test", AfterUnknown: false, AfterSensitive: false},
+					"test_sensitive":      {After: "secret", AfterUnknown: false, AfterSensitive: true},
+					"test_unknown":        {AfterUnknown: true, AfterSensitive: false},
+					"test_partly_unknown": {After: map[string]any{"known": "value"}, AfterUnknown: map[string]any{"id": true}},
+					"test_removed":        {Actions: tfjson.Actions{tfjson.ActionDelete}, Before: "old", AfterUnknown: false},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package check
+
+import (
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// record reports the outcome of a check to the Reporter, see testerror.SetReporter.
+// Checks defer it with a pointer to their named result, so that it is read on return.
+func record(check, reference string, expected any, start time.Time, result **testerror.Error) {
+	testerror.Record(check, reference, expected, nil, start, *result)
+}
@@ -0,0 +1,101 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/ops"
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// These are the replacement reasons returned by ReplaceReason.
+// ReplaceBecauseTainted and ReplaceBecauseCannotUpdate use the same values as the `action_reason` field
+// in the Terraform plan JSON. Terraform reports `replace_by_request` for `-replace` and `replace_by_triggers`
+// for `replace_triggered_by`, these cannot be told apart here so ReplaceByRequestOrTriggers is used for both.
+const (
+	ReplaceBecauseTainted      = "replace_because_tainted"
+	ReplaceBecauseCannotUpdate = "replace_because_cannot_update"
+	ReplaceByRequestOrTriggers = "replace_by_request_or_triggers"
+)
+
+// IsReplacedBecauseOf returns a *testerror.Error if the resource is not planned to be replaced,
+// or if the attributes causing the replacement are not exactly those supplied.
+// Paths are dot separated, with list indexes as numbers, e.g. `location` or `network_rules.0.ip_rules`.
+func (t ThatType) IsReplacedBecauseOf(paths ...string) (result *testerror.Error) {
+	defer record("IsReplacedBecauseOf", t.ResourceName, paths, time.Now(), &result)
+	if err := t.hasActions("replaced", tfjson.Actions.Replace); err != nil {
+		return err
+	}
+	c, _ := t.resourceChange()
+	actual := replacePaths(c)
+	expected := make([]string, len(paths))
+	copy(expected, paths)
+	sort.Strings(expected)
+	if strings.Join(actual, ",") != strings.Join(expected, ",") {
+		return testerror.Newf(
+			"%s: expected replacement because of [%s], got [%s]",
+			t.ResourceName,
+			strings.Join(expected, ", "),
+			strings.Join(actual, ", "),
+		).WithKind(testerror.ValueMismatch).WithReference(t.ResourceName).WithValues(expected, actual)
+	}
+	return nil
+}
+
+// ReplaceReason returns an ops.Operative containing the reason that the resource is planned to be replaced,
+// e.g. ReplaceBecauseCannotUpdate. The ops.Operative does not exist if the resource is not being replaced.
+//
+// The plan JSON decoded by terraform-json does not include `action_reason`, so the reason is derived:
+// a resource that is tainted in the prior state is ReplaceBecauseTainted,
+// a resource with replace paths is ReplaceBecauseCannotUpdate,
+// any other replacement, i.e. using `-replace` or `replace_triggered_by`, is ReplaceByRequestOrTriggers.
+func (t ThatType) ReplaceReason() ops.Operative {
+	o := ops.Operative{
+		Reference: fmt.Sprintf("%s (replace reason)", t.ResourceName),
+	}
+	c, err := t.resourceChange()
+	if err != nil || !c.Actions.Replace() {
+		return o
+	}
+	o.Exist = true
+	switch {
+	case t.isTainted():
+		o.Actual = ReplaceBecauseTainted
+	case len(c.ReplacePaths) > 0:
+		o.Actual = ReplaceBecauseCannotUpdate
+	default:
+		o.Actual = ReplaceByRequestOrTriggers
+	}
+	return o
+}
+
+// isTainted returns true if the resource is marked as tainted in the prior state
+func (t ThatType) isTainted() bool {
+	ps := t.Plan.RawPlan.PriorState
+	if ps == nil || ps.Values == nil {
+		return false
+	}
+	r := findStateResource(ps.Values.RootModule, t.ResourceName)
+	return r != nil && r.Tainted
+}
+
+// replacePaths returns the sorted replace paths of the change in dot separated form
+func replacePaths(c *tfjson.Change) []string {
+	paths := make([]string, 0, len(c.ReplacePaths))
+	for _, rp := range c.ReplacePaths {
+		steps, ok := rp.([]any)
+		if !ok {
+			continue
+		}
+		parts := make([]string, len(steps))
+		for i, s := range steps {
+			parts[i] = fmt.Sprint(s)
+		}
+		paths = append(paths, strings.Join(parts, "."))
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,99 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReplacedBecauseOf(t *testing.T) {
+	t.Parallel()
+
+	mock := mockReplacePlanType()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.That("test_cannot_update").IsReplacedBecauseOf("network_rules.0.ip_rules", "location").AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		err := mock.That("test_cannot_update").IsReplacedBecauseOf("location").AsError()
+		assert.ErrorContains(t, err, "test_cannot_update: expected replacement because of [location], got [location, network_rules.0.ip_rules]")
+	})
+
+	t.Run("NotReplaced", func(t *testing.T) {
+		t.Parallel()
+		err := mock.That("test_update").IsReplacedBecauseOf("location").AsError()
+		assert.ErrorContains(t, err, "test_update: expected resource to be replaced, got actions [update]")
+	})
+}
+
+func TestReplaceReason(t *testing.T) {
+	t.Parallel()
+
+	mock := mockReplacePlanType()
+
+	t.Run("CannotUpdate", func(t *testing.T) {
+		t.Parallel()
+		o := mock.That("test_cannot_update").ReplaceReason()
+		assert.NoError(t, o.HasValue(ReplaceBecauseCannotUpdate).AsError())
+	})
+
+	t.Run("Tainted", func(t *testing.T) {
+		t.Parallel()
+		o := mock.That("module.test.test_tainted").ReplaceReason()
+		assert.NoError(t, o.HasValue(ReplaceBecauseTainted).AsError())
+	})
+
+	t.Run("Requested", func(t *testing.T) {
+		t.Parallel()
+		o := mock.That("test_requested").ReplaceReason()
+		assert.NoError(t, o.HasValue(ReplaceByRequestOrTriggers).AsError())
+	})
+
+	t.Run("NotReplaced", func(t *testing.T) {
+		t.Parallel()
+		o := mock.That("test_update").ReplaceReason()
+		assert.NoError(t, o.DoesNotExist().AsError())
+		assert.ErrorContains(t, o.HasValue(ReplaceByRequestOrTriggers).AsError(), "test_update (replace reason): not found when expected")
+	})
+}
+
+func mockReplacePlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			RawPlan: tfjson.Plan{
+				PriorState: &tfjson.State{
+					Values: &tfjson.StateValues{
+						RootModule: &tfjson.StateModule{
+							Resources: []*tfjson.StateResource{
+								{Address: "test_cannot_update"},
+							},
+							ChildModules: []*tfjson.StateModule{
+								{
+									Resources: []*tfjson.StateResource{
+										{Address: "module.test.test_tainted", Tainted: true},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"test_cannot_update": {
+					Change: &tfjson.Change{
+						Actions:      tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+						ReplacePaths: []any{[]any{"network_rules", float64(0), "ip_rules"}, []any{"location"}},
+					},
+				},
+				"module.test.test_tainted": mockResourceChange(tfjson.ActionDelete, tfjson.ActionCreate),
+				"test_requested":           mockResourceChange(tfjson.ActionCreate, tfjson.ActionDelete),
+				"test_update":              mockResourceChange(tfjson.ActionUpdate),
+			},
+		},
+	}
+}
@@ -2,10 +2,12 @@ package check
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/terratest-terraform-fluent/ops"
 	"github.com/Azure/terratest-terraform-fluent/testerror"
 	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 // ThatType is a type which can be used for more fluent assertions for a given Resource
@@ -15,56 +17,124 @@ type ThatType struct {
 }
 
 // Exists returns a *testError.Error if the resource does not exist in the plan
-func (t ThatType) Exists() *testerror.Error {
+func (t ThatType) Exists() (result *testerror.Error) {
+	defer record("Exists", t.ResourceName, nil, time.Now(), &result)
 	if !t.exists() {
-		return testerror.Newf(
-			"%s: resource not found in plan",
-			t.ResourceName,
-		)
+		return t.notFound()
 	}
 	return nil
 }
 
+// notFound returns the *testerror.Error for a resource that does not exist in the plan.
+func (t ThatType) notFound() *testerror.Error {
+	return testerror.Newf(
+		"%s: resource not found in plan",
+		t.ResourceName,
+	).WithKind(testerror.NotFound).WithReference(t.ResourceName)
+}
+
 func (t *ThatType) exists() bool {
-	if _, ok := t.Plan.ResourcePlannedValuesMap[t.ResourceName]; !ok {
-		return false
+	_, ok := t.stateResource()
+	return ok
+}
+
+// stateResource returns the planned values of the resource.
+// Data sources read during plan are not in the planned values, so they are looked up in the prior state.
+func (t ThatType) stateResource() (*tfjson.StateResource, bool) {
+	if r, ok := t.Plan.ResourcePlannedValuesMap[t.ResourceName]; ok {
+		return r, true
+	}
+	ps := t.Plan.RawPlan.PriorState
+	if ps == nil || ps.Values == nil {
+		return nil, false
+	}
+	if r := findStateResource(ps.Values.RootModule, t.ResourceName); r != nil && r.Mode == tfjson.DataResourceMode {
+		return r, true
 	}
-	return true
+	return nil, false
+}
+
+// findStateResource recursively searches the module and its children for the resource with the given address.
+func findStateResource(m *tfjson.StateModule, addr string) *tfjson.StateResource {
+	if m == nil {
+		return nil
+	}
+	for _, r := range m.Resources {
+		if r.Address == addr {
+			return r
+		}
+	}
+	for _, child := range m.ChildModules {
+		if r := findStateResource(child, addr); r != nil {
+			return r
+		}
+	}
+	return nil
 }
 
 // DoesNotExist returns an *testerror.Error if the resource exists in the plan
-func (t ThatType) DoesNotExist() *testerror.Error {
+func (t ThatType) DoesNotExist() (result *testerror.Error) {
+	defer record("DoesNotExist", t.ResourceName, nil, time.Now(), &result)
 	if t.exists() {
 		return testerror.Newf(
 			"%s: resource found in plan",
 			t.ResourceName,
-		)
+		).WithKind(testerror.ValueMismatch).WithReference(t.ResourceName)
 	}
 	return nil
 }
 
-// Key returns an ops.Operative type which can be used to compare and query the data
+// Key returns an ops.Operative type which can be used to compare and query the data.
+// If the value of the key will not be known until after apply, the returned ops.Operative is marked as unknown.
+// If only parts of the value will not be known, e.g. a computed attribute of a nested block,
+// those parts are marked as unknown when queried, see ops.Operative.Query.
 func (t ThatType) Key(key string) ops.Operative {
 	ref := fmt.Sprintf("%s.%s", t.ResourceName, key)
 
-	if !t.exists() {
+	r, ok := t.stateResource()
+	if !ok {
 		return ops.Operative{
 			Exist:     false,
 			Reference: ref,
 		}
 	}
 
-	actual, ok := t.Plan.ResourcePlannedValuesMap[t.ResourceName].AttributeValues[key]
-	if !ok {
+	actual, ok := r.AttributeValues[key]
+	return plannedOperative(ref, actual, ok, t.afterUnknown(key))
+}
+
+// plannedOperative returns an ops.Operative for a planned value and its after_unknown value from the plan.
+// If the after_unknown value is true, the whole value is unknown and exists even if omitted from the plan,
+// otherwise it is kept on the ops.Operative to mark any unknown parts of the value.
+func plannedOperative(ref string, actual any, exist bool, afterUnknown any) ops.Operative {
+	if afterUnknown == true {
+		return ops.Operative{
+			Exist:     true,
+			Unknown:   true,
+			Reference: ref,
+		}
+	}
+	if !exist {
 		return ops.Operative{
 			Exist:     false,
 			Reference: ref,
 		}
 	}
-
 	return ops.Operative{
-		Exist:     true,
-		Reference: ref,
-		Actual:    actual,
+		Exist:        true,
+		Reference:    ref,
+		Actual:       actual,
+		AfterUnknown: afterUnknown,
+	}
+}
+
+// afterUnknown returns the after_unknown value of the resource change for the key,
+// this is true if the whole value is unknown, or a list or map marking its unknown parts.
+func (t ThatType) afterUnknown(key string) any {
+	rc, ok := t.Plan.ResourceChangesMap[t.ResourceName]
+	if !ok || rc.Change == nil {
+		return nil
 	}
+	au, _ := rc.Change.AfterUnknown.(map[string]any)
+	return au[key]
 }
@@ -71,6 +71,74 @@ func TestKey(t *testing.T) {
 	})
 }
 
+func TestKeyUnknown(t *testing.T) {
+	t.Parallel()
+
+	tt := mockThatType()
+	tt.Plan.ResourcePlannedValuesMap["test_resource"].AttributeValues["id"] = nil
+	tt.Plan.ResourcePlannedValuesMap["test_resource"].AttributeValues["identity"] = []any{
+		map[string]any{"type": "SystemAssigned"},
+	}
+	tt.Plan.ResourceChangesMap = map[string]*tfjson.ResourceChange{
+		"test_resource": {
+			Change: &tfjson.Change{
+				AfterUnknown: map[string]any{
+					"id":          true,
+					"computed":    true,
+					"key":         false,
+					"nested_list": []any{true},
+					"identity":    []any{map[string]any{"principal_id": true}},
+				},
+			},
+		},
+	}
+
+	t.Run("PresentAsNil", func(t *testing.T) {
+		t.Parallel()
+		o := tt.Key("id")
+		assert.True(t, o.Exist)
+		assert.True(t, o.Unknown)
+		assert.NoError(t, o.IsUnknown().AsError())
+		assert.ErrorContains(t, o.HasValue("test").AsError(), "test_resource.id: value is unknown until apply, expected test")
+	})
+
+	t.Run("Omitted", func(t *testing.T) {
+		t.Parallel()
+		o := tt.Key("computed")
+		assert.True(t, o.Exist)
+		assert.True(t, o.Unknown)
+	})
+
+	t.Run("Known", func(t *testing.T) {
+		t.Parallel()
+		o := tt.Key("key")
+		assert.False(t, o.Unknown)
+		assert.NoError(t, o.IsKnown().AsError())
+		assert.NoError(t, o.HasValue("value").AsError())
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		t.Parallel()
+		o := tt.Key("identity")
+		assert.False(t, o.Unknown)
+		assert.NoError(t, o.IsUnknown().AsError())
+		assert.ErrorContains(t, o.HasValue([]any{}).AsError(), "test_resource.identity: value is unknown until apply")
+		q := o.Query("0.principal_id")
+		assert.True(t, q.Exist)
+		assert.True(t, q.Unknown)
+		assert.NoError(t, q.IsUnknown().AsError())
+		assert.NoError(t, o.Query("0.type").HasValue("SystemAssigned").AsError())
+		assert.NoError(t, o.Query("0").IsUnknown().AsError())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		o := tt.Key("nested_list")
+		assert.False(t, o.Exist)
+		assert.False(t, o.Unknown)
+	})
+}
+
 func mockThatType() ThatType {
 	return ThatType{
 		Plan: &terraform.PlanStruct{
@@ -0,0 +1,180 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// ThatAllType is a type which can be used for more fluent assertions for a collection of resources.
+// Assertions on the collection report every failing resource, not just the first.
+type ThatAllType struct {
+	Plan          *terraform.PlanStruct
+	Pattern       string
+	ResourceNames []string
+}
+
+// ThatAll returns a ThatAllType containing all resources whose address matches the supplied glob pattern.
+// The pattern is matched against the address relative to the module scope, see InModule.
+// A `*` matches any sequence of characters and a `?` matches any single character,
+// all other characters, including `[` and `]`, are matched literally.
+// e.g. `azurerm_subnet.this[*]` matches all instances of a resource created with count or for_each.
+// Resources that are only being deleted by the plan are not included.
+func (p PlanType) ThatAll(glob string) ThatAllType {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return p.thatAll(p.address(glob), regexp.MustCompile(sb.String()))
+}
+
+// ThatAllMatching returns a ThatAllType containing all resources whose address matches the supplied regular expression.
+// The expression is matched against the address relative to the module scope, see InModule.
+func (p PlanType) ThatAllMatching(re *regexp.Regexp) ThatAllType {
+	return p.thatAll(p.address(re.String()), re)
+}
+
+func (p PlanType) thatAll(pattern string, re *regexp.Regexp) ThatAllType {
+	names := make([]string, 0)
+	prefix := p.address("")
+	for _, addr := range p.addresses() {
+		if !p.inScope(addr) {
+			continue
+		}
+		if re.MatchString(strings.TrimPrefix(addr, prefix)) {
+			names = append(names, addr)
+		}
+	}
+	return ThatAllType{
+		Plan:          p.Plan,
+		Pattern:       pattern,
+		ResourceNames: names,
+	}
+}
+
+// addresses returns the sorted, de-duplicated addresses of all resources in the planned values and resource changes,
+// excluding resources that are only being deleted.
+func (p PlanType) addresses() []string {
+	seen := make(map[string]struct{}, len(p.Plan.ResourcePlannedValuesMap))
+	for k := range p.Plan.ResourcePlannedValuesMap {
+		seen[k] = struct{}{}
+	}
+	for k, rc := range p.Plan.ResourceChangesMap {
+		if rc != nil && isDeleteOnly(rc.Change) {
+			continue
+		}
+		seen[k] = struct{}{}
+	}
+	addrs := make([]string, 0, len(seen))
+	for k := range seen {
+		addrs = append(addrs, k)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// isDeleteOnly returns true if the only planned action for the resource or output change is delete,
+// meaning it is not part of the planned state.
+func isDeleteOnly(c *tfjson.Change) bool {
+	return c != nil && c.Actions.Delete()
+}
+
+// Count returns the number of resources in the collection.
+func (t ThatAllType) Count() int {
+	return len(t.ResourceNames)
+}
+
+// CountEquals returns a *testerror.Error if the number of resources in the collection is not equal to the expected number.
+func (t ThatAllType) CountEquals(expected int) (result *testerror.Error) {
+	defer record("CountEquals", t.Pattern, expected, time.Now(), &result)
+	if actual := t.Count(); actual != expected {
+		return testerror.Newf("%s: expected %d resources, got %d", t.Pattern, expected, actual).
+			WithKind(testerror.ValueMismatch).
+			WithReference(t.Pattern).
+			WithValues(expected, actual)
+	}
+	return nil
+}
+
+// Each runs the supplied check against every resource in the collection.
+// It returns a *testerror.Error containing all failures, or if the collection is empty.
+func (t ThatAllType) Each(f func(ThatType) *testerror.Error) (result *testerror.Error) {
+	defer record("Each", t.Pattern, nil, time.Now(), &result)
+	if t.Count() == 0 {
+		return testerror.Newf("%s: no resources found in plan", t.Pattern).WithKind(testerror.NotFound).WithReference(t.Pattern)
+	}
+	if errs := t.run(f); len(errs) > 0 {
+		return testerror.Join(fmt.Sprintf("%s: %d of %d resources failed", t.Pattern, len(errs), t.Count()), errs).
+			WithKind(testerror.ValueMismatch).
+			WithReference(t.Pattern)
+	}
+	return nil
+}
+
+// Any runs the supplied check against every resource in the collection.
+// It returns a *testerror.Error containing all failures if no resource passes the check.
+func (t ThatAllType) Any(f func(ThatType) *testerror.Error) (result *testerror.Error) {
+	defer record("Any", t.Pattern, nil, time.Now(), &result)
+	if t.Count() == 0 {
+		return testerror.Newf("%s: no resources found in plan", t.Pattern).WithKind(testerror.NotFound).WithReference(t.Pattern)
+	}
+	errs := t.run(f)
+	if len(errs) == t.Count() {
+		return testerror.Join(fmt.Sprintf("%s: no resources passed", t.Pattern), errs).
+			WithKind(testerror.ValueMismatch).
+			WithReference(t.Pattern)
+	}
+	return nil
+}
+
+// None runs the supplied check against every resource in the collection.
+// It returns a *testerror.Error listing every resource that passes the check.
+func (t ThatAllType) None(f func(ThatType) *testerror.Error) (result *testerror.Error) {
+	defer record("None", t.Pattern, nil, time.Now(), &result)
+	passed := make([]string, 0)
+	for _, n := range t.ResourceNames {
+		if f(t.that(n)) == nil {
+			passed = append(passed, n)
+		}
+	}
+	if len(passed) > 0 {
+		return testerror.Newf(
+			"%s: expected no resources to pass, got %s",
+			t.Pattern,
+			strings.Join(passed, ", "),
+		).WithKind(testerror.ValueMismatch).WithReference(t.Pattern).WithValues(nil, passed)
+	}
+	return nil
+}
+
+func (t ThatAllType) that(name string) ThatType {
+	return ThatType{
+		Plan:         t.Plan,
+		ResourceName: name,
+	}
+}
+
+func (t ThatAllType) run(f func(ThatType) *testerror.Error) []*testerror.Error {
+	errs := make([]*testerror.Error, 0)
+	for _, n := range t.ResourceNames {
+		if err := f(t.that(n)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
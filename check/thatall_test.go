@@ -0,0 +1,143 @@
+package check
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThatAll(t *testing.T) {
+	t.Parallel()
+
+	mock := mockThatAllPlanType()
+
+	t.Run("Glob", func(t *testing.T) {
+		t.Parallel()
+		ta := mock.ThatAll("test_resource.this[*]")
+		assert.Equal(t, []string{"test_resource.this[0]", "test_resource.this[1]", "test_resource.this[2]"}, ta.ResourceNames)
+		assert.NoError(t, ta.CountEquals(3).AsError())
+	})
+
+	t.Run("GlobSingleChar", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 3, mock.ThatAll("test_resource.this[?]").Count())
+		assert.Equal(t, 0, mock.ThatAll("test_resource.this?").Count())
+	})
+
+	t.Run("GlobDeleted", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, mock.ThatAll("*.deleted").ResourceNames)
+		assert.Equal(t, []string{"test_resource.replaced"}, mock.ThatAll("*.replaced").ResourceNames)
+	})
+
+	t.Run("Matching", func(t *testing.T) {
+		t.Parallel()
+		ta := mock.ThatAllMatching(regexp.MustCompile(`^test_resource\.this\[[01]\]$`))
+		assert.Equal(t, 2, ta.Count())
+	})
+
+	t.Run("CountEqualsFail", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").CountEquals(1).AsError()
+		assert.ErrorContains(t, err, "test_resource.this[*]: expected 1 resources, got 3")
+	})
+}
+
+func TestThatAllEach(t *testing.T) {
+	t.Parallel()
+
+	mock := mockThatAllPlanType()
+	hasKey := func(tt ThatType) *testerror.Error { return tt.Key("key").HasValue("value") }
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").Each(func(tt ThatType) *testerror.Error { return tt.Exists() })
+		assert.NoError(t, err.AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").Each(hasKey).AsError()
+		assert.ErrorContains(t, err, "test_resource.this[*]: 2 of 3 resources failed")
+		assert.ErrorContains(t, err, "test_resource.this[1].key: expected value value not equal to actual other")
+		assert.ErrorContains(t, err, "test_resource.this[2].key: expected value value not equal to actual other")
+	})
+
+	t.Run("Kind", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, testerror.ValueMismatch, mock.ThatAll("test_resource.this[*]").Each(hasKey).Kind)
+		assert.Equal(t, testerror.ValueMismatch, mock.ThatAll("test_resource.this[1]").Any(hasKey).Kind)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("not_exists.*").Each(hasKey).AsError()
+		assert.ErrorContains(t, err, "not_exists.*: no resources found in plan")
+	})
+}
+
+func TestThatAllAny(t *testing.T) {
+	t.Parallel()
+
+	mock := mockThatAllPlanType()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").Any(func(tt ThatType) *testerror.Error { return tt.Key("key").HasValue("value") })
+		assert.NoError(t, err.AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").Any(func(tt ThatType) *testerror.Error { return tt.Key("key").HasValue("none") }).AsError()
+		assert.ErrorContains(t, err, "test_resource.this[*]: no resources passed")
+		assert.ErrorContains(t, err, "test_resource.this[0].key")
+		assert.ErrorContains(t, err, "test_resource.this[2].key")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("not_exists.*").Any(func(tt ThatType) *testerror.Error { return nil }).AsError()
+		assert.ErrorContains(t, err, "no resources found in plan")
+	})
+}
+
+func TestThatAllNone(t *testing.T) {
+	t.Parallel()
+
+	mock := mockThatAllPlanType()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").None(func(tt ThatType) *testerror.Error { return tt.Key("key").HasValue("none") })
+		assert.NoError(t, err.AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ThatAll("test_resource.this[*]").None(func(tt ThatType) *testerror.Error { return tt.Key("key").HasValue("other") }).AsError()
+		assert.ErrorContains(t, err, "expected no resources to pass, got test_resource.this[1], test_resource.this[2]")
+	})
+}
+
+func mockThatAllPlanType() PlanType {
+	return PlanType{
+		Plan: &terraform.PlanStruct{
+			ResourcePlannedValuesMap: map[string]*tfjson.StateResource{
+				"test_resource.this[0]": {AttributeValues: map[string]any{"key": "value"}},
+				"test_resource.this[1]": {AttributeValues: map[string]any{"key": "other"}},
+				"test_resource.this[2]": {AttributeValues: map[string]any{"key": "other"}},
+				"test_resource.that":    {AttributeValues: map[string]any{"key": "value"}},
+			},
+			ResourceChangesMap: map[string]*tfjson.ResourceChange{
+				"test_resource.this[0]":  mockResourceChange(tfjson.ActionCreate),
+				"test_resource.deleted":  mockResourceChange(tfjson.ActionDelete),
+				"test_resource.replaced": mockResourceChange(tfjson.ActionDelete, tfjson.ActionCreate),
+			},
+		},
+	}
+}
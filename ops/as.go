@@ -0,0 +1,67 @@
+package ops
+
+import (
+	"encoding/json"
+)
+
+// As returns the actual value of the Operative decoded into type T, using JSON encoding.
+// This allows values to be decoded into your own structs, as well as basic types.
+// If the actual value is a string which cannot be decoded directly, it is decoded as JSON,
+// so JSON encoded attributes and numeric strings are supported.
+// It returns a *testerror.Error if the value does not exist, is unknown or cannot be decoded.
+func As[T any](o Operative) (T, error) {
+	var res T
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return res, err
+	}
+
+	b, err := json.Marshal(o.Actual)
+	if err == nil {
+		if err = json.Unmarshal(b, &res); err == nil {
+			return res, nil
+		}
+	}
+
+	if s, ok := o.Actual.(string); ok {
+		var fromString T
+		if json.Unmarshal([]byte(s), &fromString) == nil {
+			return fromString, nil
+		}
+	}
+
+	var zero T
+	return zero, typeMismatchf(
+		o,
+		"%s: cannot convert value %v to %T: %v",
+		o.Reference,
+		o.Actual,
+		zero,
+		err,
+	).Wrap(err)
+}
+
+// AsString returns the actual value as a string, see As
+func (o Operative) AsString() (string, error) {
+	return As[string](o)
+}
+
+// AsInt returns the actual value as an int, see As.
+// Numbers with a fractional part cannot be converted.
+func (o Operative) AsInt() (int, error) {
+	return As[int](o)
+}
+
+// AsBool returns the actual value as a bool, see As
+func (o Operative) AsBool() (bool, error) {
+	return As[bool](o)
+}
+
+// AsStringSlice returns the actual value as a []string, see As
+func (o Operative) AsStringSlice() ([]string, error) {
+	return As[[]string](o)
+}
+
+// AsMap returns the actual value as a map[string]any, see As
+func (o Operative) AsMap() (map[string]any, error) {
+	return As[map[string]any](o)
+}
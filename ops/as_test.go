@@ -0,0 +1,93 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Struct", func(t *testing.T) {
+		t.Parallel()
+		type rule struct {
+			Name     string `json:"name"`
+			Priority int    `json:"priority"`
+		}
+		mock := mockOperativeType([]any{
+			map[string]any{"name": "allow", "priority": float64(100)},
+		})
+		rules, err := As[[]rule](mock)
+		require.NoError(t, err)
+		assert.Equal(t, []rule{{Name: "allow", Priority: 100}}, rules)
+	})
+
+	t.Run("JSONString", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"effect":"deny"}`)
+		v, err := As[map[string]string](mock)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"effect": "deny"}, v)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		t.Parallel()
+		_, err := As[[]string](mockOperativeType("test"))
+		assert.ErrorContains(t, err, "test_resource.test_key: cannot convert value test to []string")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(any(nil))
+		mock.Exist = false
+		_, err := As[string](mock)
+		assert.ErrorContains(t, err, "not found when expected")
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(any(nil))
+		mock.Unknown = true
+		_, err := As[string](mock)
+		assert.ErrorContains(t, err, "value is unknown until apply")
+	})
+}
+
+func TestAsHelpers(t *testing.T) {
+	t.Parallel()
+
+	s, err := mockOperativeType("test").AsString()
+	require.NoError(t, err)
+	assert.Equal(t, "test", s)
+	_, err = mockOperativeType(float64(1)).AsString()
+	assert.ErrorContains(t, err, "test_resource.test_key: cannot convert value 1 to string")
+
+	i, err := mockOperativeType(float64(3)).AsInt()
+	require.NoError(t, err)
+	assert.Equal(t, 3, i)
+	i, err = mockOperativeType("3").AsInt()
+	require.NoError(t, err)
+	assert.Equal(t, 3, i)
+	_, err = mockOperativeType(2.5).AsInt()
+	assert.ErrorContains(t, err, "test_resource.test_key: cannot convert value 2.5 to int")
+
+	b, err := mockOperativeType(true).AsBool()
+	require.NoError(t, err)
+	assert.True(t, b)
+	_, err = mockOperativeType("yes").AsBool()
+	assert.ErrorContains(t, err, "cannot convert value yes to bool")
+
+	ss, err := mockOperativeType([]any{"10.0.0.0/16", "10.1.0.0/16"}).AsStringSlice()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/16", "10.1.0.0/16"}, ss)
+	_, err = mockOperativeType([]any{float64(1)}).AsStringSlice()
+	assert.ErrorContains(t, err, "cannot convert value [1] to []string")
+
+	m, err := mockOperativeType(map[string]any{"env": "prod"}).AsMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"env": "prod"}, m)
+	_, err = mockOperativeType([]any{}).AsMap()
+	assert.ErrorContains(t, err, "cannot convert value [] to map[string]interface {}")
+}
@@ -0,0 +1,225 @@
+package ops
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+)
+
+// HasLength returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a list, set, map or string of the expected length.
+func (o Operative) HasLength(expected int) (result *testerror.Error) {
+	defer o.record("HasLength", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	actual, ok := length(o.Actual)
+	if !ok {
+		return typeMismatchf(o, "%s: cannot get length of value %v", o.Reference, o.Actual)
+	}
+	if actual != expected {
+		return mismatchf(
+			o,
+			expected,
+			"%s: expected length %d, got %d",
+			o.Reference,
+			expected,
+			actual,
+		)
+	}
+	return nil
+}
+
+// IsEmpty returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a null or empty list, set, map or string.
+func (o Operative) IsEmpty() (result *testerror.Error) {
+	defer o.record("IsEmpty", nil, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	if o.Actual == nil {
+		return nil
+	}
+	actual, ok := length(o.Actual)
+	if !ok {
+		return typeMismatchf(o, "%s: cannot get length of value %v", o.Reference, o.Actual)
+	}
+	if actual != 0 {
+		return mismatchf(o, nil, "%s: expected empty value, got %v", o.Reference, o.Actual)
+	}
+	return nil
+}
+
+// ContainsElement returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a list or set containing the expected element.
+func (o Operative) ContainsElement(expected any) (result *testerror.Error) {
+	defer o.record("ContainsElement", expected, time.Now(), &result)
+	return o.quietly().ContainsAllElements(expected)
+}
+
+// ContainsAllElements returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a list or set containing all of the expected elements, in any order.
+func (o Operative) ContainsAllElements(expected ...any) (result *testerror.Error) {
+	defer o.record("ContainsAllElements", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	actual, err := o.elements()
+	if err != nil {
+		return err
+	}
+	missing := make([]string, 0)
+	for _, e := range expected {
+		if indexOf(actual, e) < 0 {
+			missing = append(missing, fmt.Sprint(e))
+		}
+	}
+	if len(missing) > 0 {
+		return mismatchf(
+			o,
+			expected,
+			"%s: expected elements [%s] not found in %v",
+			o.Reference,
+			strings.Join(missing, ", "),
+			o.Actual,
+		)
+	}
+	return nil
+}
+
+// ElementsMatch returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a list or set containing exactly the expected elements, ignoring order.
+// This is useful for Terraform sets, where the order of elements is not defined.
+func (o Operative) ElementsMatch(expected ...any) (result *testerror.Error) {
+	defer o.record("ElementsMatch", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	actual, err := o.elements()
+	if err != nil {
+		return err
+	}
+
+	used := make([]bool, len(actual))
+	missing := make([]string, 0)
+	for _, e := range expected {
+		found := false
+		for i, a := range actual {
+			if !used[i] && assert.ObjectsAreEqualValues(e, a) {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, fmt.Sprint(e))
+		}
+	}
+	extra := make([]string, 0)
+	for i, a := range actual {
+		if !used[i] {
+			extra = append(extra, fmt.Sprintf("[%d]: %v", i, a))
+		}
+	}
+
+	if len(missing) > 0 || len(extra) > 0 {
+		msgs := make([]string, 0, 2)
+		if len(missing) > 0 {
+			msgs = append(msgs, fmt.Sprintf("missing elements [%s]", strings.Join(missing, ", ")))
+		}
+		if len(extra) > 0 {
+			msgs = append(msgs, fmt.Sprintf("unexpected elements %s", strings.Join(extra, ", ")))
+		}
+		return mismatchf(o, expected, "%s: elements do not match, %s", o.Reference, strings.Join(msgs, ", "))
+	}
+	return nil
+}
+
+// ContainsKey returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a map or object containing the expected key.
+func (o Operative) ContainsKey(key string) (result *testerror.Error) {
+	defer o.record("ContainsKey", key, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	m, ok := o.Actual.(map[string]any)
+	if !ok {
+		return typeMismatchf(o, "%s: value is not a map", o.Reference)
+	}
+	if _, ok := m[key]; !ok {
+		return mismatchf(o, key, "%s[%q]: key not found", o.Reference, key)
+	}
+	return nil
+}
+
+// HasKeyWithValue returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not a map or object containing the expected key and value.
+func (o Operative) HasKeyWithValue(key string, expected any) (result *testerror.Error) {
+	defer o.record("HasKeyWithValue", expected, time.Now(), &result)
+	if err := o.quietly().ContainsKey(key); err != nil {
+		return err
+	}
+	actual := o.Actual.(map[string]any)[key]
+	if !assert.ObjectsAreEqualValues(expected, actual) {
+		ref := fmt.Sprintf("%s[%q]", o.Reference, key)
+		return testerror.Newf("%s", mismatchMessage(ref, expected, actual)).
+			WithKind(testerror.ValueMismatch).
+			WithReference(ref).
+			WithValues(expected, actual)
+	}
+	return nil
+}
+
+// elements returns the elements of a list or set value.
+func (o Operative) elements() ([]any, *testerror.Error) {
+	if o.Actual == nil {
+		return []any{}, nil
+	}
+	v := reflect.ValueOf(o.Actual)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, typeMismatchf(o, "%s: value is not a list or set", o.Reference)
+	}
+	els := make([]any, v.Len())
+	for i := range els {
+		els[i] = v.Index(i).Interface()
+	}
+	return els, nil
+}
+
+// indexOf returns the index of the element in the list, or -1 if not present.
+func indexOf(list []any, element any) int {
+	for i, e := range list {
+		if assert.ObjectsAreEqualValues(element, e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// length returns the length of a list, set, map or string value.
+func length(v any) (int, bool) {
+	if v == nil {
+		return 0, false
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		return rv.Len(), true
+	}
+	return 0, false
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,100 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasLength(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, mockOperativeType([]any{"a", "b"}).HasLength(2).AsError())
+	assert.NoError(t, mockOperativeType(map[string]any{"a": 1}).HasLength(1).AsError())
+	assert.NoError(t, mockOperativeType("abc").HasLength(3).AsError())
+	err := mockOperativeType([]any{"a"}).HasLength(2).AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: expected length 2, got 1")
+	err = mockOperativeType(float64(1)).HasLength(1).AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: cannot get length of value 1")
+}
+
+func TestIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, mockOperativeType([]any{}).IsEmpty().AsError())
+	assert.NoError(t, mockOperativeType(map[string]any{}).IsEmpty().AsError())
+	assert.NoError(t, mockOperativeType(any(nil)).IsEmpty().AsError())
+	err := mockOperativeType([]any{"a"}).IsEmpty().AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: expected empty value, got [a]")
+	err = mockOperativeType(true).IsEmpty().AsError()
+	assert.ErrorContains(t, err, "cannot get length of value true")
+}
+
+func TestContainsElement(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{"10.0.0.0/16", float64(2), map[string]any{"name": "a"}})
+	assert.NoError(t, mock.ContainsElement("10.0.0.0/16").AsError())
+	assert.NoError(t, mock.ContainsElement(2).AsError())
+	assert.NoError(t, mock.ContainsElement(map[string]any{"name": "a"}).AsError())
+	err := mock.ContainsElement("10.1.0.0/16").AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: expected elements [10.1.0.0/16] not found in")
+	err = mockOperativeType("string").ContainsElement("s").AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: value is not a list or set")
+}
+
+func TestContainsAllElements(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{"a", "b", "c"})
+	assert.NoError(t, mock.ContainsAllElements("c", "a").AsError())
+	err := mock.ContainsAllElements("a", "d", "e").AsError()
+	assert.ErrorContains(t, err, "expected elements [d, e] not found in [a b c]")
+
+	notFound := mockOperativeType(any(nil))
+	notFound.Exist = false
+	assert.ErrorContains(t, notFound.ContainsAllElements("a").AsError(), "not found when expected")
+}
+
+func TestElementsMatch(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{"a", "b", "b"})
+	assert.NoError(t, mock.ElementsMatch("b", "a", "b").AsError())
+	err := mock.ElementsMatch("a", "b").AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: elements do not match, unexpected elements [2]: b")
+	err = mock.ElementsMatch("a", "b", "b", "c").AsError()
+	assert.ErrorContains(t, err, "elements do not match, missing elements [c]")
+	err = mock.ElementsMatch("a", "c", "b").AsError()
+	assert.ErrorContains(t, err, "elements do not match, missing elements [c], unexpected elements [2]: b")
+	assert.NoError(t, mockOperativeType([]string{"x", "y"}).ElementsMatch("y", "x").AsError())
+}
+
+func TestContainsKey(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(map[string]any{"env": "prod"})
+	assert.NoError(t, mock.ContainsKey("env").AsError())
+	err := mock.ContainsKey("owner").AsError()
+	assert.ErrorContains(t, err, `test_resource.test_key["owner"]: key not found`)
+	err = mockOperativeType([]any{}).ContainsKey("env").AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: value is not a map")
+}
+
+func TestHasKeyWithValue(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(map[string]any{"env": "prod", "count": float64(3)})
+	assert.NoError(t, mock.HasKeyWithValue("env", "prod").AsError())
+	assert.NoError(t, mock.HasKeyWithValue("count", 3).AsError())
+	err := mock.HasKeyWithValue("env", "dev").AsError()
+	assert.ErrorContains(t, err, `test_resource.test_key["env"]: expected value dev not equal to actual prod`)
+	err = mock.HasKeyWithValue("owner", "me").AsError()
+	assert.ErrorContains(t, err, `test_resource.test_key["owner"]: key not found`)
+
+	nested := mockOperativeType(map[string]any{"tags": map[string]any{"env": "prod", "owner": "me"}})
+	err = nested.HasKeyWithValue("tags", map[string]any{"env": "dev", "owner": "me"}).AsError()
+	assert.ErrorContains(t, err, `test_resource.test_key["tags"]: expected value not equal to actual (- expected, + actual):`)
+	assert.ErrorContains(t, err, `~ .env: "dev" => "prod"`)
+	assert.NotContains(t, err.Error(), "map[")
+}
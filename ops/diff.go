@@ -0,0 +1,136 @@
+package ops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// maxDiffValueLength is the maximum length of a value printed in a diff, longer values are truncated.
+const maxDiffValueLength = 80
+
+// diff returns a path by path description of the differences between expected and actual,
+// with one line per added, removed or changed value, in the form "- path: expected",
+// "+ path: actual" or "~ path: expected => actual".
+//
+// Maps are compared by key and lists by index. The values are normalised to their JSON representation first.
+// It returns nil if neither value is a map or list, or if no differences are found.
+func diff(expected, actual any) []string {
+	exp, err := normalise(expected)
+	if err != nil {
+		return nil
+	}
+	act, err := normalise(actual)
+	if err != nil {
+		return nil
+	}
+	if !isComposite(exp) && !isComposite(act) {
+		return nil
+	}
+	return diffLines("", exp, act)
+}
+
+func diffLines(path string, expected, actual any) []string {
+	switch exp := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+		lines := make([]string, 0)
+		keys := make(map[string]any, len(exp)+len(act))
+		for k := range exp {
+			keys[k] = nil
+		}
+		for k := range act {
+			keys[k] = nil
+		}
+		for _, k := range sortedKeys(keys) {
+			p := fmt.Sprintf("%s.%s", path, k)
+			e, eok := exp[k]
+			a, aok := act[k]
+			switch {
+			case !aok:
+				lines = append(lines, fmt.Sprintf("- %s: %s", p, truncate(e)))
+			case !eok:
+				lines = append(lines, fmt.Sprintf("+ %s: %s", p, truncate(a)))
+			default:
+				lines = append(lines, diffLines(p, e, a)...)
+			}
+		}
+		return lines
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			break
+		}
+		lines := make([]string, 0)
+		for i := 0; i < len(exp) || i < len(act); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(act):
+				lines = append(lines, fmt.Sprintf("- %s: %s", p, truncate(exp[i])))
+			case i >= len(exp):
+				lines = append(lines, fmt.Sprintf("+ %s: %s", p, truncate(act[i])))
+			default:
+				lines = append(lines, diffLines(p, exp[i], act[i])...)
+			}
+		}
+		return lines
+	}
+	if assert.ObjectsAreEqual(expected, actual) {
+		return nil
+	}
+	if path == "" {
+		path = "."
+	}
+	return []string{fmt.Sprintf("~ %s: %s => %s", path, truncate(expected), truncate(actual))}
+}
+
+// mismatchMessage returns the message for an actual value at the reference not equal to the expected value.
+// If either value is a map or list, the message contains a path by path diff rather than the values inline.
+func mismatchMessage(reference string, expected, actual any) string {
+	if d := diff(expected, actual); len(d) > 0 {
+		return fmt.Sprintf("%s: expected value not equal to actual (- expected, + actual):%s", reference, formatDiff(d))
+	}
+	return fmt.Sprintf("%s: expected value %v not equal to actual %v", reference, expected, actual)
+}
+
+// describe returns the value for inclusion in an error message,
+// maps and lists are described by their type rather than printed inline.
+func describe(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "map"
+	case []any:
+		return "list"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// formatDiff formats the diff lines for inclusion in an error message.
+func formatDiff(lines []string) string {
+	return "\n  " + strings.Join(lines, "\n  ")
+}
+
+func isComposite(v any) bool {
+	switch v.(type) {
+	case map[string]any, []any:
+		return true
+	}
+	return false
+}
+
+// truncate returns the string representation of the value, truncated to maxDiffValueLength characters.
+// It counts runes rather than bytes, so that multi-byte characters are not split.
+func truncate(v any) string {
+	s := fmt.Sprintf("%v", v)
+	if str, ok := v.(string); ok {
+		s = fmt.Sprintf("%q", str)
+	}
+	if r := []rune(s); len(r) > maxDiffValueLength {
+		return string(r[:maxDiffValueLength-3]) + "..."
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+package ops
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Map", func(t *testing.T) {
+		t.Parallel()
+		expected := map[string]any{
+			"sku":  "Standard",
+			"tags": map[string]string{"owner": "me", "env": "prod"},
+			"same": 1,
+		}
+		actual := map[string]any{
+			"sku":   "Premium",
+			"tags":  map[string]any{"env": "prod", "cost": "123"},
+			"same":  float64(1),
+			"extra": true,
+		}
+		assert.Equal(t, []string{
+			"+ .extra: true",
+			`~ .sku: "Standard" => "Premium"`,
+			`+ .tags.cost: "123"`,
+			`- .tags.owner: "me"`,
+		}, diff(expected, actual))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{
+			`~ [1]: "b" => "c"`,
+			`+ [2]: "d"`,
+		}, diff([]string{"a", "b"}, []any{"a", "c", "d"}))
+		assert.Equal(t, []string{
+			`- [1].name: "b"`,
+			`- [2]: map[name:c]`,
+		}, diff([]any{map[string]any{"name": "a"}, map[string]any{"name": "b"}, map[string]any{"name": "c"}}, []any{map[string]any{"name": "a"}, map[string]any{}}))
+	})
+
+	t.Run("TypeChange", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{`~ .: "a" => [a]`}, diff("a", []any{"a"}))
+		assert.Equal(t, []string{`~ .a: map[b:1] => [1]`}, diff(map[string]any{"a": map[string]any{"b": 1}}, map[string]any{"a": []any{1}}))
+	})
+
+	t.Run("Scalar", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, diff("a", "b"))
+	})
+
+	t.Run("Equal", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, diff([]string{"a"}, []any{"a"}))
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		t.Parallel()
+		long := strings.Repeat("x", 200)
+		d := diff(map[string]any{"a": long}, map[string]any{})
+		assert.Len(t, d, 1)
+		assert.Len(t, d[0], len("- .a: ")+maxDiffValueLength)
+		assert.True(t, strings.HasSuffix(d[0], "..."))
+	})
+
+	t.Run("TruncateMultiByte", func(t *testing.T) {
+		t.Parallel()
+		long := "x" + strings.Repeat("é", 200)
+		d := diff(map[string]any{"a": long}, map[string]any{})
+		assert.Len(t, d, 1)
+		assert.True(t, utf8.ValidString(d[0]))
+		assert.Equal(t, len("- .a: ")+maxDiffValueLength, utf8.RuneCountInString(d[0]))
+		err := mockOperativeType(map[string]any{}).HasValue(map[string]any{"a": long})
+		require.NotNil(t, err)
+		assert.True(t, utf8.ValidString(err.Error()))
+	})
+}
+
+func TestHasValueDiff(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(map[string]any{
+		"sku":  "Premium",
+		"tags": map[string]any{"env": "prod"},
+	})
+	err := mock.HasValue(map[string]any{
+		"sku":  "Standard",
+		"tags": map[string]any{"env": "prod", "owner": "me"},
+	})
+	require.NotNil(t, err)
+	assert.Equal(t, "test_resource.test_key: expected value not equal to actual (- expected, + actual):\n"+
+		"  ~ .sku: \"Standard\" => \"Premium\"\n"+
+		"  - .tags.owner: \"me\"", err.Message())
+}
@@ -0,0 +1,111 @@
+package ops
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// ForEach runs the supplied check against every element of a list or set value,
+// passing the index and an Operative for the element, whose Reference is extended with `[i]`.
+// It returns a non-nil *testerror.Error containing every failing element.
+// An empty or null list passes.
+func (o Operative) ForEach(f func(i int, el Operative) *testerror.Error) (result *testerror.Error) {
+	defer o.record("ForEach", nil, time.Now(), &result)
+	els, err := o.elementOperatives()
+	if err != nil {
+		return err
+	}
+	errs := make([]*testerror.Error, 0)
+	for i, el := range els {
+		if err := f(i, el); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return testerror.Join(fmt.Sprintf("%s: %d of %d elements failed", o.Reference, len(errs), len(els)), errs).
+		WithKind(testerror.ValueMismatch).
+		WithReference(o.Reference)
+}
+
+// All runs the supplied check against every element of a list or set value.
+// It returns a non-nil *testerror.Error containing every failing element.
+// An empty or null list passes.
+func (o Operative) All(f func(el Operative) *testerror.Error) (result *testerror.Error) {
+	defer o.record("All", nil, time.Now(), &result)
+	return o.quietly().ForEach(func(_ int, el Operative) *testerror.Error {
+		return f(el)
+	})
+}
+
+// Any runs the supplied check against every element of a list or set value.
+// It returns a non-nil *testerror.Error containing every failing element if no element passes,
+// including if the list is empty or null.
+func (o Operative) Any(f func(el Operative) *testerror.Error) (result *testerror.Error) {
+	defer o.record("Any", nil, time.Now(), &result)
+	els, err := o.elementOperatives()
+	if err != nil {
+		return err
+	}
+	if len(els) == 0 {
+		return mismatchf(o, nil, "%s: no elements found", o.Reference)
+	}
+	errs := make([]*testerror.Error, 0)
+	for _, el := range els {
+		err := f(el)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return testerror.Join(fmt.Sprintf("%s: no elements passed", o.Reference), errs).
+		WithKind(testerror.ValueMismatch).
+		WithReference(o.Reference)
+}
+
+// None runs the supplied check against every element of a list or set value.
+// It returns a non-nil *testerror.Error listing every element that passes the check.
+func (o Operative) None(f func(el Operative) *testerror.Error) (result *testerror.Error) {
+	defer o.record("None", nil, time.Now(), &result)
+	els, err := o.elementOperatives()
+	if err != nil {
+		return err
+	}
+	errs := make([]*testerror.Error, 0)
+	for _, el := range els {
+		if f(el) == nil {
+			errs = append(errs, mismatchf(el, nil, "%s: passed when not expected", el.Reference))
+		}
+	}
+	return testerror.Join(fmt.Sprintf("%s: %d of %d elements passed", o.Reference, len(errs), len(els)), errs).
+		WithKind(testerror.ValueMismatch).
+		WithReference(o.Reference)
+}
+
+// elementOperatives returns an Operative for each element of a list or set value.
+func (o Operative) elementOperatives() ([]Operative, *testerror.Error) {
+	// Elements with unknown parts are reported by the check on that element, so only a wholly unknown value is an error.
+	known := o
+	known.AfterUnknown = nil
+	if err := isErrorOrNotExistOrUnknown(known); err != nil {
+		return nil, err
+	}
+	els, err := o.elements()
+	if err != nil {
+		return nil, err
+	}
+	unknown, _ := o.AfterUnknown.([]any)
+	res := make([]Operative, len(els))
+	for i, el := range els {
+		res[i] = Operative{
+			Reference: fmt.Sprintf("%s[%d]", o.Reference, i),
+			Actual:    el,
+			Exist:     true,
+		}
+		if i < len(unknown) {
+			res[i].Unknown = unknown[i] == true
+			res[i].AfterUnknown = unknown[i]
+		}
+	}
+	return res, nil
+}
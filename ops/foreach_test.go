@@ -0,0 +1,111 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEach(t *testing.T) {
+	t.Parallel()
+
+	rules := mockOperativeType([]any{
+		map[string]any{"access": "Deny", "priority": float64(100)},
+		map[string]any{"access": "Allow", "priority": float64(200)},
+		map[string]any{"access": "Allow", "priority": float64(4096)},
+		map[string]any{"access": "Allow", "priority": float64(300)},
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		err := rules.ForEach(func(i int, el Operative) *testerror.Error {
+			return el.Query("priority").GreaterThan(0)
+		})
+		assert.NoError(t, err.AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		indexes := make([]int, 0)
+		err := rules.ForEach(func(i int, el Operative) *testerror.Error {
+			indexes = append(indexes, i)
+			if el.Query("priority").GreaterThan(4000) == nil {
+				return nil
+			}
+			return el.Query("access").HasValue("Deny")
+		}).AsError()
+		assert.Equal(t, []int{0, 1, 2, 3}, indexes)
+		assert.ErrorContains(t, err, "test_resource.test_key: 2 of 4 elements failed")
+		assert.ErrorContains(t, err, "test_resource.test_key[1]?access: expected value Deny not equal to actual Allow")
+		assert.ErrorContains(t, err, "test_resource.test_key[3]?access: expected value Deny not equal to actual Allow")
+	})
+
+	t.Run("Kind", func(t *testing.T) {
+		t.Parallel()
+		err := rules.ForEach(func(i int, el Operative) *testerror.Error {
+			return el.Query("priority").LessThan(4000)
+		})
+		assert.Equal(t, testerror.ValueMismatch, err.Kind)
+		err = rules.All(func(el Operative) *testerror.Error { return el.Query("priority").LessThan(4000) })
+		assert.Equal(t, testerror.ValueMismatch, err.Kind)
+		err = rules.Any(func(el Operative) *testerror.Error { return el.Query("priority").LessThan(0) })
+		assert.Equal(t, testerror.ValueMismatch, err.Kind)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType([]any{}).ForEach(func(i int, el Operative) *testerror.Error {
+			return testerror.New("should not be called")
+		})
+		assert.NoError(t, err.AsError())
+	})
+
+	t.Run("NotAList", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType("test").ForEach(func(i int, el Operative) *testerror.Error { return nil }).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value is not a list or set")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(any(nil))
+		mock.Exist = false
+		err := mock.ForEach(func(i int, el Operative) *testerror.Error { return nil }).AsError()
+		assert.ErrorContains(t, err, "not found when expected")
+	})
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{"a", "b"})
+	assert.NoError(t, mock.All(func(el Operative) *testerror.Error { return el.MatchesRegex(`^[a-z]$`) }).AsError())
+	err := mock.All(func(el Operative) *testerror.Error { return el.HasValue("a") }).AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: 1 of 2 elements failed")
+	assert.ErrorContains(t, err, "test_resource.test_key[1]: expected value a not equal to actual b")
+}
+
+func TestAny(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{"a", "b"})
+	assert.NoError(t, mock.Any(func(el Operative) *testerror.Error { return el.HasValue("b") }).AsError())
+	err := mock.Any(func(el Operative) *testerror.Error { return el.HasValue("c") }).AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: no elements passed")
+	assert.ErrorContains(t, err, "test_resource.test_key[0]: expected value c not equal to actual a")
+	assert.ErrorContains(t, err, "test_resource.test_key[1]: expected value c not equal to actual b")
+	err = mockOperativeType([]any{}).Any(func(el Operative) *testerror.Error { return nil }).AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: no elements found")
+}
+
+func TestNone(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{"a", "b", "a"})
+	assert.NoError(t, mock.None(func(el Operative) *testerror.Error { return el.HasValue("c") }).AsError())
+	err := mock.None(func(el Operative) *testerror.Error { return el.HasValue("a") }).AsError()
+	assert.ErrorContains(t, err, "test_resource.test_key: 2 of 3 elements passed")
+	assert.ErrorContains(t, err, "test_resource.test_key[0]: passed when not expected")
+	assert.ErrorContains(t, err, "test_resource.test_key[2]: passed when not expected")
+}
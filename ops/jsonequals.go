@@ -0,0 +1,78 @@
+package ops
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+)
+
+// JSONEquals returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not semantically equal to the expected value when both are treated as JSON.
+// Strings are decoded as JSON, other values, including structs, are marshalled to JSON first.
+// This means differences in key order and whitespace are ignored.
+// On failure, the error contains a path by path diff.
+func (o Operative) JSONEquals(expected any) (result *testerror.Error) {
+	defer o.record("JSONEquals", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	exp, err := decodeJSON(expected)
+	if err != nil {
+		return testerror.Newf("invalid operation: expected value %v not valid JSON (%s)", expected, err).
+			WithKind(testerror.InvalidJSON).
+			WithValues(expected, nil).
+			Wrap(err)
+	}
+	act, err := decodeJSON(o.Actual)
+	if err != nil {
+		return testerror.Newf(
+			"%s: actual value %v not valid JSON",
+			o.Reference,
+			o.Actual,
+		).WithKind(testerror.InvalidJSON).WithReference(o.Reference).WithValues(nil, o.Actual).Wrap(err)
+	}
+	if assert.ObjectsAreEqual(exp, act) {
+		return nil
+	}
+	if isComposite(exp) || isComposite(act) {
+		return mismatchf(
+			o,
+			expected,
+			"%s: expected JSON not equal to actual (- expected, + actual):%s",
+			o.Reference,
+			formatDiff(diffLines("", exp, act)),
+		)
+	}
+	expJSON, _ := json.Marshal(exp)
+	actJSON, _ := json.Marshal(act)
+	return mismatchf(
+		o,
+		expected,
+		"%s: expected JSON %s not equal to actual %s",
+		o.Reference,
+		expJSON,
+		actJSON,
+	)
+}
+
+// JSONEquals returns a non-nil *testerror.Error if the value of the key is semantically equal to the expected value as JSON
+func (n NotType) JSONEquals(expected any) (result *testerror.Error) {
+	defer n.record("Not.JSONEquals", expected, time.Now(), &result)
+	return n.negate(n.o.JSONEquals(expected), "expected JSON not to be %s", jsonBytes(expected))
+}
+
+// decodeJSON decodes the JSON representation of the value, see jsonBytes.
+func decodeJSON(v any) (any, error) {
+	b := jsonBytes(v)
+	if b == nil {
+		return nil, fmt.Errorf("cannot marshal %T to JSON", v)
+	}
+	var res any
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONEquals(t *testing.T) {
+	t.Parallel()
+
+	policy := `{
+		"if":   {"field": "location", "notIn": ["westeurope", "northeurope"]},
+		"then": {"effect": "deny"}
+	}`
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"then":{"effect":"deny"},"if":{"notIn":["westeurope","northeurope"],"field":"location"}}`)
+		assert.NoError(t, mock.JSONEquals(policy).AsError())
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		t.Parallel()
+		type then struct {
+			Effect string `json:"effect"`
+		}
+		expected := struct {
+			Then then `json:"then"`
+		}{Then: then{Effect: "deny"}}
+		mock := mockOperativeType(`{ "then" : { "effect" : "deny" } }`)
+		assert.NoError(t, mock.JSONEquals(expected).AsError())
+	})
+
+	t.Run("Object", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(map[string]any{"count": float64(1)})
+		assert.NoError(t, mock.JSONEquals(`{"count": 1.0}`).AsError())
+		assert.NoError(t, mock.JSONEquals(map[string]int{"count": 1}).AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"if":{"field":"location","notIn":["westeurope"]},"then":{"effect":"audit"}}`)
+		err := mock.JSONEquals(policy)
+		require.NotNil(t, err)
+		assert.Equal(t, "test_resource.test_key: expected JSON not equal to actual (- expected, + actual):\n"+
+			"  - .if.notIn[1]: \"northeurope\"\n"+
+			"  ~ .then.effect: \"deny\" => \"audit\"", err.Message())
+	})
+
+	t.Run("FailureScalar", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType(`"a"`).JSONEquals(`"b"`).AsError()
+		assert.ErrorContains(t, err, `test_resource.test_key: expected JSON "b" not equal to actual "a"`)
+	})
+
+	t.Run("InvalidActual", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType("not json").JSONEquals(policy).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: actual value not json not valid JSON")
+	})
+
+	t.Run("InvalidExpected", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType("{}").JSONEquals("{").AsError()
+		assert.ErrorContains(t, err, "invalid operation: expected value { not valid JSON")
+		err = mockOperativeType("{}").JSONEquals(func() {}).AsError()
+		assert.ErrorContains(t, err, "cannot marshal func() to JSON")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(any(nil))
+		mock.Exist = false
+		assert.ErrorContains(t, mock.JSONEquals("{}").AsError(), "not found when expected")
+	})
+
+	t.Run("Not", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"a": 1}`)
+		assert.NoError(t, mock.Not().JSONEquals(`{"a": 2}`).AsError())
+		err := mock.Not().JSONEquals(`{ "a" : 1 }`).AsError()
+		assert.ErrorContains(t, err, `test_resource.test_key: expected JSON not to be { "a" : 1 }`)
+	})
+}
+
+func TestNotJSONEqualsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	assert.ErrorContains(t, mockOperativeType(`{"a":`).Not().JSONEquals(`{"a": 1}`).AsError(), "not valid JSON")
+	assert.ErrorContains(t, mockOperativeType(`{"a": 1}`).Not().JSONEquals(`{"a":`).AsError(), "invalid operation")
+}
@@ -0,0 +1,102 @@
+package ops
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// MatchesJSONSchema returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key does not validate against the supplied JSON schema.
+// Schemas without a `$schema` keyword are treated as draft 2020-12.
+// String values are decoded as JSON before validation, other values are validated as they are.
+// All violations are reported, each with the JSON pointer of the offending value.
+func (o Operative) MatchesJSONSchema(schema []byte) (result *testerror.Error) {
+	defer o.record("MatchesJSONSchema", nil, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+
+	sch, err := compileJSONSchema(schema)
+	if err != nil {
+		return testerror.Newf("invalid JSON schema: %v", err).WithKind(testerror.InvalidJSON).Wrap(err)
+	}
+
+	instance, err := jsonInstance(o.Actual)
+	if err != nil {
+		return testerror.Newf(
+			"%s: actual value %v not valid JSON",
+			o.Reference,
+			o.Actual,
+		).WithKind(testerror.InvalidJSON).WithReference(o.Reference).WithValues(nil, o.Actual).Wrap(err)
+	}
+
+	err = sch.Validate(instance)
+	if err == nil {
+		return nil
+	}
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return testerror.Newf("%s: validating JSON schema: %v", o.Reference, err).WithReference(o.Reference).Wrap(err)
+	}
+	errs := make([]*testerror.Error, 0)
+	for _, leaf := range leafValidationErrors(verr) {
+		errs = append(errs, mismatchf(o, nil, "%s: %s", o.Reference, leaf.Error()).Wrap(leaf))
+	}
+	return testerror.Join(fmt.Sprintf("%s: value does not match JSON schema", o.Reference), errs).
+		WithKind(testerror.ValueMismatch).
+		WithReference(o.Reference)
+}
+
+// MatchesJSONSchemaFile returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key does not validate against the JSON schema in the supplied file, see MatchesJSONSchema.
+func (o Operative) MatchesJSONSchemaFile(path string) (result *testerror.Error) {
+	defer o.record("MatchesJSONSchemaFile", path, time.Now(), &result)
+	schema, err := os.ReadFile(path) // #nosec G304 -- path is supplied by the test author
+	if err != nil {
+		return testerror.Newf("reading JSON schema file %s: %v", path, err).Wrap(err)
+	}
+	return o.quietly().MatchesJSONSchema(schema)
+}
+
+// MatchesJSONSchema returns a non-nil *testerror.Error if the value of the key validates against the supplied JSON schema
+func (n NotType) MatchesJSONSchema(schema []byte) (result *testerror.Error) {
+	defer n.record("Not.MatchesJSONSchema", nil, time.Now(), &result)
+	return n.negate(n.o.MatchesJSONSchema(schema), "expected value not to match JSON schema")
+}
+
+func compileJSONSchema(schema []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema))
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	if err := c.AddResource("schema.json", doc); err != nil {
+		return nil, err
+	}
+	return c.Compile("schema.json")
+}
+
+// jsonInstance returns the value decoded in the form expected by the JSON schema validator.
+func jsonInstance(v any) (any, error) {
+	return jsonschema.UnmarshalJSON(bytes.NewReader(jsonBytes(v)))
+}
+
+// leafValidationErrors returns the validation errors with no further causes,
+// these are the individual violations of the schema.
+func leafValidationErrors(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	leaves := make([]*jsonschema.ValidationError, 0)
+	for _, c := range err.Causes {
+		leaves = append(leaves, leafValidationErrors(c)...)
+	}
+	return leaves
+}
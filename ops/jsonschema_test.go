@@ -0,0 +1,92 @@
+package ops
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	schema, err := os.ReadFile("testdata/schema.json")
+	require.NoError(t, err)
+
+	t.Run("SuccessString", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"name":"test","properties":{"sku":"Standard","count":2}}`)
+		assert.NoError(t, mock.MatchesJSONSchema(schema).AsError())
+	})
+
+	t.Run("SuccessObject", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(map[string]any{
+			"name":       "test",
+			"properties": map[string]any{"sku": "Premium", "count": float64(1)},
+		})
+		assert.NoError(t, mock.MatchesJSONSchema(schema).AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"name":"","properties":{"sku":"Basic","count":1.5}}`)
+		err := mock.MatchesJSONSchema(schema).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value does not match JSON schema")
+		assert.ErrorContains(t, err, "test_resource.test_key: at '/name'")
+		assert.ErrorContains(t, err, "test_resource.test_key: at '/properties/sku'")
+		assert.ErrorContains(t, err, "test_resource.test_key: at '/properties/count'")
+	})
+
+	t.Run("MissingProperty", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"name":"test"}`)
+		err := mock.MatchesJSONSchema(schema).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: at '': missing property 'properties'")
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType("not json").MatchesJSONSchema(schema).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: actual value not json not valid JSON")
+	})
+
+	t.Run("InvalidSchema", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType("{}").MatchesJSONSchema([]byte(`{"type": 1}`)).AsError()
+		assert.ErrorContains(t, err, "invalid JSON schema")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(any(nil))
+		mock.Exist = false
+		assert.ErrorContains(t, mock.MatchesJSONSchema(schema).AsError(), "not found when expected")
+	})
+
+	t.Run("Not", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(`{"name":"test","properties":{"sku":"Standard"}}`)
+		err := mock.Not().MatchesJSONSchema(schema).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: expected value not to match JSON schema")
+		assert.NoError(t, mockOperativeType(`{}`).Not().MatchesJSONSchema(schema).AsError())
+	})
+}
+
+func TestMatchesJSONSchemaFile(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(`{"name":"test","properties":{"sku":"Standard"}}`)
+	assert.NoError(t, mock.MatchesJSONSchemaFile("testdata/schema.json").AsError())
+	err := mock.MatchesJSONSchemaFile("testdata/not_exists.json").AsError()
+	assert.ErrorContains(t, err, "reading JSON schema file testdata/not_exists.json")
+}
+
+func TestNotMatchesJSONSchemaInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	schema := []byte(`{"type": "object"}`)
+	assert.ErrorContains(t, mockOperativeType(`{"a":`).Not().MatchesJSONSchema(schema).AsError(), "not valid JSON")
+	assert.ErrorContains(t, mockOperativeType(`{}`).Not().MatchesJSONSchema([]byte(`{`)).AsError(), "invalid JSON schema")
+}
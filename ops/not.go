@@ -0,0 +1,145 @@
+package ops
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// NotType is a type which negates the assertions of an Operative, see Operative.Not.
+type NotType struct {
+	o Operative
+}
+
+// Not returns a NotType which can be used to negate the assertions of the Operative,
+// e.g. `o.Not().HasValue("TLS1_0")`.
+// Negated assertions still return a non-nil *testerror.Error if the value does not exist or is unknown.
+func (o Operative) Not() NotType {
+	return NotType{o: o.quietly()}
+}
+
+// HasValue returns a non-nil *testerror.Error if the value of the key matches the expected value
+func (n NotType) HasValue(expected any) (result *testerror.Error) {
+	defer n.record("Not.HasValue", expected, time.Now(), &result)
+	return n.negate(n.o.HasValue(expected), "expected value not to be %v", expected)
+}
+
+// ContainsString returns a non-nil *testerror.Error if the value of the key contains the expected string
+func (n NotType) ContainsString(expected string) (result *testerror.Error) {
+	defer n.record("Not.ContainsString", expected, time.Now(), &result)
+	return n.negate(n.o.ContainsString(expected), "expected value %v not to contain %s", n.o.Actual, expected)
+}
+
+// MatchesRegex returns a non-nil *testerror.Error if the value of the key matches the regular expression pattern
+func (n NotType) MatchesRegex(pattern string) (result *testerror.Error) {
+	defer n.record("Not.MatchesRegex", pattern, time.Now(), &result)
+	return n.negate(n.o.MatchesRegex(pattern), "expected value %v not to match pattern %s", n.o.Actual, pattern)
+}
+
+// GreaterThan returns a non-nil *testerror.Error if the value of the key is greater than the expected value
+func (n NotType) GreaterThan(expected any) (result *testerror.Error) {
+	defer n.record("Not.GreaterThan", expected, time.Now(), &result)
+	return n.negate(n.o.GreaterThan(expected), "expected value %v not to be greater than %v", n.o.Actual, expected)
+}
+
+// GreaterOrEqual returns a non-nil *testerror.Error if the value of the key is greater than or equal to the expected value
+func (n NotType) GreaterOrEqual(expected any) (result *testerror.Error) {
+	defer n.record("Not.GreaterOrEqual", expected, time.Now(), &result)
+	return n.negate(n.o.GreaterOrEqual(expected), "expected value %v not to be greater than or equal to %v", n.o.Actual, expected)
+}
+
+// LessThan returns a non-nil *testerror.Error if the value of the key is less than the expected value
+func (n NotType) LessThan(expected any) (result *testerror.Error) {
+	defer n.record("Not.LessThan", expected, time.Now(), &result)
+	return n.negate(n.o.LessThan(expected), "expected value %v not to be less than %v", n.o.Actual, expected)
+}
+
+// LessOrEqual returns a non-nil *testerror.Error if the value of the key is less than or equal to the expected value
+func (n NotType) LessOrEqual(expected any) (result *testerror.Error) {
+	defer n.record("Not.LessOrEqual", expected, time.Now(), &result)
+	return n.negate(n.o.LessOrEqual(expected), "expected value %v not to be less than or equal to %v", n.o.Actual, expected)
+}
+
+// Between returns a non-nil *testerror.Error if the value of the key is within the inclusive range lower to upper
+func (n NotType) Between(lower, upper any) (result *testerror.Error) {
+	defer n.record("Not.Between", []any{lower, upper}, time.Now(), &result)
+	return n.negate(n.o.Between(lower, upper), "expected value %v not to be between %v and %v", n.o.Actual, lower, upper)
+}
+
+// ApproxEqual returns a non-nil *testerror.Error if the value of the key is within the tolerance of the expected value
+func (n NotType) ApproxEqual(expected any, tolerance float64) (result *testerror.Error) {
+	defer n.record("Not.ApproxEqual", expected, time.Now(), &result)
+	return n.negate(n.o.ApproxEqual(expected, tolerance), "expected value %v not to be within %v of %v", n.o.Actual, tolerance, expected)
+}
+
+// HasLength returns a non-nil *testerror.Error if the value of the key has the expected length
+func (n NotType) HasLength(expected int) (result *testerror.Error) {
+	defer n.record("Not.HasLength", expected, time.Now(), &result)
+	return n.negate(n.o.HasLength(expected), "expected length not to be %d", expected)
+}
+
+// IsEmpty returns a non-nil *testerror.Error if the value of the key is null or empty
+func (n NotType) IsEmpty() (result *testerror.Error) {
+	defer n.record("Not.IsEmpty", nil, time.Now(), &result)
+	return n.negate(n.o.IsEmpty(), "expected value not to be empty")
+}
+
+// ContainsElement returns a non-nil *testerror.Error if the value of the key contains the expected element
+func (n NotType) ContainsElement(expected any) (result *testerror.Error) {
+	defer n.record("Not.ContainsElement", expected, time.Now(), &result)
+	return n.negate(n.o.ContainsElement(expected), "expected element %v not to be found in %v", expected, n.o.Actual)
+}
+
+// ContainsAllElements returns a non-nil *testerror.Error if the value of the key contains all of the expected elements
+func (n NotType) ContainsAllElements(expected ...any) (result *testerror.Error) {
+	defer n.record("Not.ContainsAllElements", expected, time.Now(), &result)
+	return n.negate(n.o.ContainsAllElements(expected...), "expected elements [%s] not all to be found in %v", sprintAll(expected), n.o.Actual)
+}
+
+// ElementsMatch returns a non-nil *testerror.Error if the value of the key contains exactly the expected elements, ignoring order
+func (n NotType) ElementsMatch(expected ...any) (result *testerror.Error) {
+	defer n.record("Not.ElementsMatch", expected, time.Now(), &result)
+	return n.negate(n.o.ElementsMatch(expected...), "expected elements not to match [%s]", sprintAll(expected))
+}
+
+// ContainsKey returns a non-nil *testerror.Error if the value of the key contains the expected map key
+func (n NotType) ContainsKey(key string) (result *testerror.Error) {
+	defer n.record("Not.ContainsKey", key, time.Now(), &result)
+	return n.negate(n.o.ContainsKey(key), "expected key %q not to be found", key)
+}
+
+// HasKeyWithValue returns a non-nil *testerror.Error if the value of the key contains the expected map key and value
+func (n NotType) HasKeyWithValue(key string, expected any) (result *testerror.Error) {
+	defer n.record("Not.HasKeyWithValue", expected, time.Now(), &result)
+	return n.negate(n.o.HasKeyWithValue(key, expected), "expected key %q not to have value %v", key, expected)
+}
+
+// negate returns nil if the positive assertion failed with a ValueMismatch, or a *testerror.Error if it passed.
+// Other errors, such as the value not existing, being unknown or being of the wrong type, are always returned.
+func (n NotType) negate(positive *testerror.Error, format string, args ...any) *testerror.Error {
+	if err := isErrorOrNotExistOrUnknown(n.o); err != nil {
+		return err
+	}
+	if positive != nil {
+		if positive.Kind != testerror.ValueMismatch {
+			return positive
+		}
+		return nil
+	}
+	return mismatchf(n.o, nil, "%s: %s", n.o.Reference, fmt.Sprintf(format, args...))
+}
+
+// record reports the outcome of a negated assertion, see Operative.record.
+func (n NotType) record(check string, expected any, start time.Time, result **testerror.Error) {
+	testerror.Record(check, n.o.Reference, expected, n.o.Actual, start, *result)
+}
+
+func sprintAll(vals []any) string {
+	s := make([]string, len(vals))
+	for i, v := range vals {
+		s[i] = fmt.Sprint(v)
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,117 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+
+	str := mockOperativeType("TLS1_2")
+	num := mockOperativeType(float64(5))
+	list := mockOperativeType([]any{"a", "b"})
+	obj := mockOperativeType(map[string]any{"env": "prod"})
+
+	tests := []struct {
+		name   string
+		err    error
+		errMsg string
+	}{
+		{"HasValue", str.Not().HasValue("TLS1_0").AsError(), ""},
+		{"HasValueFail", str.Not().HasValue("TLS1_2").AsError(), "test_resource.test_key: expected value not to be TLS1_2"},
+		{"ContainsString", str.Not().ContainsString("1_0").AsError(), ""},
+		{"ContainsStringFail", str.Not().ContainsString("TLS").AsError(), "expected value TLS1_2 not to contain TLS"},
+		{"MatchesRegex", str.Not().MatchesRegex(`^SSL`).AsError(), ""},
+		{"MatchesRegexFail", str.Not().MatchesRegex(`^TLS`).AsError(), "expected value TLS1_2 not to match pattern ^TLS"},
+		{"GreaterThan", num.Not().GreaterThan(5).AsError(), ""},
+		{"GreaterThanFail", num.Not().GreaterThan(4).AsError(), "expected value 5 not to be greater than 4"},
+		{"GreaterOrEqual", num.Not().GreaterOrEqual(6).AsError(), ""},
+		{"GreaterOrEqualFail", num.Not().GreaterOrEqual(5).AsError(), "expected value 5 not to be greater than or equal to 5"},
+		{"LessThan", num.Not().LessThan(5).AsError(), ""},
+		{"LessThanFail", num.Not().LessThan(6).AsError(), "expected value 5 not to be less than 6"},
+		{"LessOrEqual", num.Not().LessOrEqual(4).AsError(), ""},
+		{"LessOrEqualFail", num.Not().LessOrEqual(5).AsError(), "expected value 5 not to be less than or equal to 5"},
+		{"Between", num.Not().Between(6, 10).AsError(), ""},
+		{"BetweenFail", num.Not().Between(1, 10).AsError(), "expected value 5 not to be between 1 and 10"},
+		{"ApproxEqual", num.Not().ApproxEqual(7, 1).AsError(), ""},
+		{"ApproxEqualFail", num.Not().ApproxEqual(6, 1).AsError(), "expected value 5 not to be within 1 of 6"},
+		{"HasLength", list.Not().HasLength(3).AsError(), ""},
+		{"HasLengthFail", list.Not().HasLength(2).AsError(), "expected length not to be 2"},
+		{"IsEmpty", list.Not().IsEmpty().AsError(), ""},
+		{"IsEmptyFail", mockOperativeType([]any{}).Not().IsEmpty().AsError(), "expected value not to be empty"},
+		{"ContainsElement", list.Not().ContainsElement("c").AsError(), ""},
+		{"ContainsElementFail", list.Not().ContainsElement("a").AsError(), "expected element a not to be found in [a b]"},
+		{"ContainsAllElements", list.Not().ContainsAllElements("a", "c").AsError(), ""},
+		{"ContainsAllElementsFail", list.Not().ContainsAllElements("a", "b").AsError(), "expected elements [a, b] not all to be found in [a b]"},
+		{"ElementsMatch", list.Not().ElementsMatch("a").AsError(), ""},
+		{"ElementsMatchFail", list.Not().ElementsMatch("b", "a").AsError(), "expected elements not to match [b, a]"},
+		{"ContainsKey", obj.Not().ContainsKey("owner").AsError(), ""},
+		{"ContainsKeyFail", obj.Not().ContainsKey("env").AsError(), `expected key "env" not to be found`},
+		{"HasKeyWithValue", obj.Not().HasKeyWithValue("env", "dev").AsError(), ""},
+		{"HasKeyWithValueFail", obj.Not().HasKeyWithValue("env", "prod").AsError(), `expected key "env" not to have value prod`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if tc.errMsg == "" {
+				assert.NoError(t, tc.err)
+				return
+			}
+			assert.ErrorContains(t, tc.err, tc.errMsg)
+		})
+	}
+}
+
+func TestNotNotFound(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(any(nil))
+	mock.Exist = false
+	assert.ErrorContains(t, mock.Not().HasValue("test").AsError(), "test_resource.test_key: not found when expected")
+
+	mock = mockOperativeType(any(nil))
+	mock.Unknown = true
+	assert.ErrorContains(t, mock.Not().HasValue("test").AsError(), "test_resource.test_key: value is unknown until apply")
+}
+
+func TestNotInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	num := mockOperativeType(float64(5))
+	str := mockOperativeType("TLS1_2")
+
+	tests := []struct {
+		name   string
+		err    error
+		errMsg string
+	}{
+		{"ContainsStringNotString", num.Not().ContainsString("TLS").AsError(), "Cannot convert value to string: test_resource.test_key"},
+		{"MatchesRegexInvalidPattern", str.Not().MatchesRegex("[").AsError(), "invalid pattern ["},
+		{"MatchesRegexNotString", num.Not().MatchesRegex("^TLS").AsError(), "Cannot convert value to string: test_resource.test_key"},
+		{"GreaterThanNotNumber", str.Not().GreaterThan(4).AsError(), "cannot convert value TLS1_2 to number"},
+		{"BetweenInvalidBound", num.Not().Between(1, "ten").AsError(), "invalid operation"},
+		{"HasLengthNotCollection", num.Not().HasLength(1).AsError(), "cannot get length of value 5"},
+		{"ContainsElementNotList", str.Not().ContainsElement("a").AsError(), "value is not a list or set"},
+		{"ContainsKeyNotMap", str.Not().ContainsKey("a").AsError(), "value is not a map"},
+		{"HasValueFunc", str.Not().HasValue(func() {}).AsError(), "invalid operation"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorContains(t, tc.err, tc.errMsg)
+		})
+	}
+}
+
+func TestNotTypeMismatch(t *testing.T) {
+	t.Parallel()
+
+	err := mockOperativeType(float64(5)).Not().ContainsString("TLS").AsError()
+	assert.ErrorIs(t, err, testerror.TypeMismatch)
+	assert.ErrorContains(t, err, "Cannot convert value to string: test_resource.test_key")
+}
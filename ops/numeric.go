@@ -0,0 +1,171 @@
+package ops
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// GreaterThan returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not numerically greater than the expected value.
+// Numbers, JSON numbers and numeric strings are all supported.
+func (o Operative) GreaterThan(expected any) (result *testerror.Error) {
+	defer o.record("GreaterThan", expected, time.Now(), &result)
+	return o.compareNumber(expected, "greater than", func(a, e float64) bool { return a > e })
+}
+
+// GreaterOrEqual returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not numerically greater than or equal to the expected value.
+func (o Operative) GreaterOrEqual(expected any) (result *testerror.Error) {
+	defer o.record("GreaterOrEqual", expected, time.Now(), &result)
+	return o.compareNumber(expected, "greater than or equal to", func(a, e float64) bool { return a >= e })
+}
+
+// LessThan returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not numerically less than the expected value.
+func (o Operative) LessThan(expected any) (result *testerror.Error) {
+	defer o.record("LessThan", expected, time.Now(), &result)
+	return o.compareNumber(expected, "less than", func(a, e float64) bool { return a < e })
+}
+
+// LessOrEqual returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not numerically less than or equal to the expected value.
+func (o Operative) LessOrEqual(expected any) (result *testerror.Error) {
+	defer o.record("LessOrEqual", expected, time.Now(), &result)
+	return o.compareNumber(expected, "less than or equal to", func(a, e float64) bool { return a <= e })
+}
+
+// Between returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is not within the inclusive range lower to upper.
+func (o Operative) Between(lower, upper any) (result *testerror.Error) {
+	defer o.record("Between", []any{lower, upper}, time.Now(), &result)
+	if err := o.quietly().GreaterOrEqual(lower); err != nil {
+		return err
+	}
+	return o.quietly().LessOrEqual(upper)
+}
+
+// ApproxEqual returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key differs from the expected value by more than the tolerance.
+func (o Operative) ApproxEqual(expected any, tolerance float64) (result *testerror.Error) {
+	defer o.record("ApproxEqual", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	a, e, err := o.numbers(expected)
+	if err != nil {
+		return err
+	}
+	if math.Abs(a-e) > tolerance {
+		return mismatchf(
+			o,
+			expected,
+			"%s: expected value %v to be within %v of %v",
+			o.Reference,
+			o.Actual,
+			tolerance,
+			expected,
+		)
+	}
+	return nil
+}
+
+func (o Operative) compareNumber(expected any, desc string, cmp func(actual, expected float64) bool) *testerror.Error {
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	a, e, err := o.numbers(expected)
+	if err != nil {
+		return err
+	}
+	if !cmp(a, e) {
+		return mismatchf(
+			o,
+			expected,
+			"%s: expected value %v to be %s %v",
+			o.Reference,
+			o.Actual,
+			desc,
+			expected,
+		)
+	}
+	return nil
+}
+
+// numbers converts the actual and expected values to float64.
+func (o Operative) numbers(expected any) (float64, float64, *testerror.Error) {
+	e, ok := toFloat64(expected)
+	if !ok {
+		return 0, 0, testerror.Newf("invalid operation: %#v is not a number", expected).
+			WithKind(testerror.TypeMismatch).
+			WithReference(o.Reference).
+			WithValues(expected, o.Actual)
+	}
+	a, ok := toFloat64(o.Actual)
+	if !ok {
+		return 0, 0, typeMismatchf(o, "%s: cannot convert value %v to number", o.Reference, o.Actual)
+	}
+	return a, e, nil
+}
+
+// decimalNumber matches a decimal number, optionally signed and with an exponent.
+// Unlike strconv.ParseFloat, it does not match hexadecimal numbers, `Inf` or `NaN`.
+var decimalNumber = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// toFloat64 converts numeric types, JSON numbers and numeric strings to a finite float64.
+// Strings must be decimal numbers, NaN and infinite values are not numbers that can be compared.
+func toFloat64(v any) (float64, bool) {
+	f, ok := anyToFloat64(v)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+func anyToFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		return parseDecimal(string(n))
+	case string:
+		return parseDecimal(strings.TrimSpace(n))
+	}
+	return 0, false
+}
+
+// parseDecimal parses a decimal number string, see decimalNumber.
+func parseDecimal(s string) (float64, bool) {
+	if !decimalNumber.MatchString(s) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
@@ -0,0 +1,86 @@
+package ops
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericComparisons(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		actual any
+		f      func(Operative) error
+		errMsg string
+	}{
+		{"GreaterThanFloat", float64(3), func(o Operative) error { return o.GreaterThan(2).AsError() }, ""},
+		{"GreaterThanFail", float64(3), func(o Operative) error { return o.GreaterThan(3).AsError() }, "test_resource.test_key: expected value 3 to be greater than 3"},
+		{"GreaterOrEqualInt", 3, func(o Operative) error { return o.GreaterOrEqual(3).AsError() }, ""},
+		{"GreaterOrEqualFail", 2, func(o Operative) error { return o.GreaterOrEqual(3.5).AsError() }, "expected value 2 to be greater than or equal to 3.5"},
+		{"LessThanString", "2.5", func(o Operative) error { return o.LessThan("3").AsError() }, ""},
+		{"LessThanFail", "4", func(o Operative) error { return o.LessThan(3).AsError() }, "expected value 4 to be less than 3"},
+		{"LessOrEqualJSONNumber", json.Number("3"), func(o Operative) error { return o.LessOrEqual(3).AsError() }, ""},
+		{"LessOrEqualFail", json.Number("4"), func(o Operative) error { return o.LessOrEqual(int64(3)).AsError() }, "expected value 4 to be less than or equal to 3"},
+		{"Between", float64(128), func(o Operative) error { return o.Between(32, 128).AsError() }, ""},
+		{"BetweenLow", float64(16), func(o Operative) error { return o.Between(32, 128).AsError() }, "expected value 16 to be greater than or equal to 32"},
+		{"BetweenHigh", float64(256), func(o Operative) error { return o.Between(32, 128).AsError() }, "expected value 256 to be less than or equal to 128"},
+		{"ApproxEqual", 0.3, func(o Operative) error { return o.ApproxEqual(0.1+0.2, 1e-9).AsError() }, ""},
+		{"ApproxEqualFail", float64(10), func(o Operative) error { return o.ApproxEqual(12, 1).AsError() }, "test_resource.test_key: expected value 10 to be within 1 of 12"},
+		{"NotANumber", "abc", func(o Operative) error { return o.GreaterThan(1).AsError() }, "test_resource.test_key: cannot convert value abc to number"},
+		{"ExpectedNotANumber", float64(1), func(o Operative) error { return o.GreaterThan("abc").AsError() }, "invalid operation: \"abc\" is not a number"},
+		{"ApproxEqualNotANumber", true, func(o Operative) error { return o.ApproxEqual(1, 1).AsError() }, "cannot convert value true to number"},
+		{"StringWithSpaceAndExponent", " 1.5e2 ", func(o Operative) error { return o.GreaterThan(".5").AsError() }, ""},
+		{"StringInf", "Inf", func(o Operative) error { return o.GreaterThan(1).AsError() }, "test_resource.test_key: cannot convert value Inf to number"},
+		{"StringInfinity", "-infinity", func(o Operative) error { return o.LessThan(1).AsError() }, "cannot convert value -infinity to number"},
+		{"StringNaN", "NaN", func(o Operative) error { return o.GreaterThan(1).AsError() }, "cannot convert value NaN to number"},
+		{"StringHex", "0x1p4", func(o Operative) error { return o.GreaterThan(1).AsError() }, "cannot convert value 0x1p4 to number"},
+		{"StringOverflow", "1e400", func(o Operative) error { return o.GreaterThan(1).AsError() }, "cannot convert value 1e400 to number"},
+		{"FloatNaN", math.NaN(), func(o Operative) error { return o.GreaterThan(1).AsError() }, "cannot convert value NaN to number"},
+		{"JSONNumberInf", json.Number("Inf"), func(o Operative) error { return o.GreaterThan(1).AsError() }, "cannot convert value Inf to number"},
+		{"ExpectedInf", float64(1), func(o Operative) error { return o.LessThan(math.Inf(1)).AsError() }, "invalid operation: +Inf is not a number"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.f(mockOperativeType(tc.actual))
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestNumericNotFound(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(any(nil))
+	mock.Exist = false
+	assert.ErrorContains(t, mock.GreaterThan(1).AsError(), "not found when expected")
+	assert.ErrorContains(t, mock.ApproxEqual(1, 1).AsError(), "not found when expected")
+
+	mock = mockOperativeType(any(nil))
+	mock.Unknown = true
+	assert.ErrorContains(t, mock.LessThan(1).AsError(), "value is unknown until apply")
+}
+
+func TestNumericTypeMismatch(t *testing.T) {
+	t.Parallel()
+
+	var te *testerror.Error
+	err := mockOperativeType("Inf").GreaterThan(1).AsError()
+	assert.ErrorIs(t, err, testerror.TypeMismatch)
+
+	err = mockOperativeType(float64(1)).GreaterThan("abc").AsError()
+	assert.ErrorIs(t, err, testerror.TypeMismatch)
+	require.ErrorAs(t, err, &te)
+	assert.Equal(t, "test_resource.test_key", te.Reference)
+}
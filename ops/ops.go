@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/Azure/terratest-terraform-fluent/testerror"
 	"github.com/stretchr/testify/assert"
@@ -14,16 +15,25 @@ import (
 // JsonAssertionFunc is a function which can be used to unmarshal a raw JSON message and check its contents.
 type JsonAssertionFunc func(input json.RawMessage) (*bool, error)
 
-// Operative is a type which can be used to compare the expected and actual values of a given combination
+// Operative is a type which can be used to compare the expected and actual values of a given combination.
+// If Unknown is true, the value exists but will not be known until after apply (computed),
+// and Actual will be nil.
+// AfterUnknown holds the after_unknown value from the plan for a list, set, map or object value,
+// marking which parts of the value will not be known until after apply.
+// A value with any unknown part is treated as unknown, use Query to access the parts that are known.
 type Operative struct {
-	Reference string
-	Actual    any
-	Exist     bool
-	err       *testerror.Error
+	Reference    string
+	Actual       any
+	Exist        bool
+	Unknown      bool
+	AfterUnknown any
+	err          *testerror.Error
+	quiet        bool
 }
 
 // Exists returns a non-nil *testerror.Error if the resource does not exist in the plan or if the key does not exist in the resource
-func (o Operative) Exists() *testerror.Error {
+func (o Operative) Exists() (result *testerror.Error) {
+	defer o.record("Exists", nil, time.Now(), &result)
 	if err := isErrorOrNotExist(o); err != nil {
 		return err
 	}
@@ -31,11 +41,49 @@ func (o Operative) Exists() *testerror.Error {
 }
 
 // DoesNotExist returns a non-nil *testerror.Error if the resource does not exist in the plan or if the key exists in the resource
-func (o Operative) DoesNotExist() *testerror.Error {
+func (o Operative) DoesNotExist() (result *testerror.Error) {
+	defer o.record("DoesNotExist", nil, time.Now(), &result)
 	if o.Exist {
 		return testerror.Newf(
 			"%s: found when not expected",
 			o.Reference,
+		).WithKind(testerror.ValueMismatch).WithReference(o.Reference)
+	}
+	return nil
+}
+
+// IsUnknown returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key is known at plan time
+func (o Operative) IsUnknown() (result *testerror.Error) {
+	defer o.record("IsUnknown", nil, time.Now(), &result)
+	if err := isErrorOrNotExist(o); err != nil {
+		return err
+	}
+	if !o.isUnknown() {
+		return mismatchf(
+			o,
+			nil,
+			"%s: expected value to be unknown until apply, got %v",
+			o.Reference,
+			o.Actual,
+		)
+	}
+	return nil
+}
+
+// IsKnown returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key will not be known until after apply
+func (o Operative) IsKnown() (result *testerror.Error) {
+	defer o.record("IsKnown", nil, time.Now(), &result)
+	if err := isErrorOrNotExist(o); err != nil {
+		return err
+	}
+	if o.isUnknown() {
+		return mismatchf(
+			o,
+			nil,
+			"%s: value is unknown until apply",
+			o.Reference,
 		)
 	}
 	return nil
@@ -43,44 +91,51 @@ func (o Operative) DoesNotExist() *testerror.Error {
 
 // HasValue returns a non-nil *testerror.Error if the resource does not exist in the plan
 // or if the value of the key does not match the expected value
-func (o Operative) HasValue(expected any) *testerror.Error {
+func (o Operative) HasValue(expected any) (result *testerror.Error) {
+	defer o.record("HasValue", expected, time.Now(), &result)
 	if err := isErrorOrNotExist(o); err != nil {
 		return err
 	}
 
+	if o.isUnknown() {
+		return testerror.Newf(
+			"%s: value is unknown until apply, expected %v",
+			o.Reference,
+			expected,
+		).WithKind(testerror.ValueMismatch).WithReference(o.Reference).WithValues(expected, nil)
+	}
+
 	if err := validateEqualArgs(expected, o.Actual); err != nil {
 		return testerror.Newf("invalid operation: %#v == %#v (%s)",
 			expected,
 			o.Actual,
 			err,
-		)
+		).WithKind(testerror.TypeMismatch).WithReference(o.Reference).WithValues(expected, o.Actual).Wrap(err)
 	}
 
 	if !assert.ObjectsAreEqualValues(expected, o.Actual) {
-		return testerror.Newf(
-			"%s: expected value %v not equal to actual %v",
-			o.Reference,
-			expected,
-			o.Actual,
-		)
+		return mismatchf(o, expected, "%s", mismatchMessage(o.Reference, expected, o.Actual))
 	}
 	return nil
 }
 
 // ContainsString returns a non-nil *testerror.Error if the resource does not exist in the plan or if
 // the value of the key does not contain the expected string
-func (o Operative) ContainsString(expected string) *testerror.Error {
-	if err := isErrorOrNotExist(o); err != nil {
+func (o Operative) ContainsString(expected string) (result *testerror.Error) {
+	defer o.record("ContainsString", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
 		return err
 	}
 
 	actualString, ok := o.Actual.(string)
 	if !ok {
-		return testerror.Newf("Cannot convert value to string: %s", o.Reference)
+		return typeMismatchf(o, "Cannot convert value to string: %s", o.Reference)
 	}
 
 	if !strings.Contains(actualString, expected) {
-		return testerror.Newf(
+		return mismatchf(
+			o,
+			expected,
 			"%s: expected value %s not contained within %s",
 			o.Reference,
 			expected,
@@ -92,7 +147,7 @@ func (o Operative) ContainsString(expected string) *testerror.Error {
 
 // GetValue returns the actual value and a *testerror.Error
 func (o Operative) GetValue() (any, error) {
-	if err := isErrorOrNotExist(o); err != nil {
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
 		return nil, err
 	}
 	return o.Actual, nil
@@ -100,13 +155,16 @@ func (o Operative) GetValue() (any, error) {
 
 // ContainsJsonValue returns a *testerror.Error which asserts upon a given JSON string set
 // by deserializing it and then asserting on it via the JsonAssertionFunc.
-func (o Operative) ContainsJsonValue(assertion JsonAssertionFunc) *testerror.Error {
-	if err := isErrorOrNotExist(o); err != nil {
+func (o Operative) ContainsJsonValue(assertion JsonAssertionFunc) (result *testerror.Error) {
+	defer o.record("ContainsJsonValue", nil, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
 		return err
 	}
 
 	if o.Actual == nil || o.Actual == "" {
-		return testerror.Newf(
+		return mismatchf(
+			o,
+			nil,
 			"%s: is empty",
 			o.Reference,
 		)
@@ -114,7 +172,8 @@ func (o Operative) ContainsJsonValue(assertion JsonAssertionFunc) *testerror.Err
 
 	actual, actualok := o.Actual.(string)
 	if !actualok {
-		return testerror.Newf(
+		return typeMismatchf(
+			o,
 			"%s: value is not a string",
 			o.Reference,
 		)
@@ -128,11 +187,13 @@ func (o Operative) ContainsJsonValue(assertion JsonAssertionFunc) *testerror.Err
 			o.Reference,
 			o.Actual,
 			err,
-		)
+		).WithReference(o.Reference).Wrap(err)
 	}
 
 	if assertok == nil || !*assertok {
-		return testerror.Newf(
+		return mismatchf(
+			o,
+			nil,
 			"%s: assertion failed for %q",
 			o.Reference,
 			o.Actual,
@@ -152,33 +213,47 @@ func (o Operative) Query(query string) Operative {
 		return o
 	}
 	o.Reference = fmt.Sprintf("%s?%s", o.Reference, query)
-	var bytes []byte
-	// If the actual value is a string, we assume it is JSON and try to parse it.
-	// Otherwise, we marshal it to JSON and try to parse it.
-	actual, ok := o.Actual.(string)
-	if ok {
-		bytes = []byte(actual)
-	} else {
-		bytes, _ = json.Marshal(o.Actual)
+	// An unknown value remains unknown, whatever part of it is queried.
+	if o.Unknown {
+		return o
 	}
+	bytes := jsonBytes(o.Actual)
 
 	if !gjson.ValidBytes(bytes) {
 		o.err = testerror.Newf(
 			"%s: actual value %s not valid JSON",
 			o.Reference,
 			o.Actual,
-		)
+		).WithKind(testerror.InvalidJSON).WithReference(o.Reference).WithValues(nil, o.Actual)
 		o.Actual = nil
 		return o
 	}
 
 	o.Actual = gjson.GetBytes(bytes, query).Value()
+	if o.AfterUnknown != nil {
+		o.AfterUnknown = gjson.GetBytes(jsonBytes(o.AfterUnknown), query).Value()
+	}
+	// Unknown parts of a value are omitted from the planned values, but are marked in after_unknown.
+	if o.AfterUnknown == true {
+		o.Unknown = true
+		o.AfterUnknown = nil
+		o.Actual = nil
+		return o
+	}
 	if o.Actual == nil {
 		o.Exist = false
 	}
 	return o
 }
 
+// WithError returns a copy of the Operative whose assertions return the supplied error,
+// for use when the value could not be looked up, e.g. because the resource is not in the plan changes.
+func (o Operative) WithError(err *testerror.Error) Operative {
+	o.Exist = false
+	o.err = err
+	return o
+}
+
 // validateEqualArgs checks whether provided arguments can be safely used in the
 // HasValue function.
 func validateEqualArgs(expected, actual any) error {
@@ -207,7 +282,95 @@ func isErrorOrNotExist(o Operative) *testerror.Error {
 		return testerror.Newf(
 			"%s: not found when expected",
 			o.Reference,
-		)
+		).WithKind(testerror.NotFound).WithReference(o.Reference)
+	}
+	return nil
+}
+
+func isErrorOrNotExistOrUnknown(o Operative) *testerror.Error {
+	if err := isErrorOrNotExist(o); err != nil {
+		return err
+	}
+	if o.isUnknown() {
+		return testerror.Newf(
+			"%s: value is unknown until apply",
+			o.Reference,
+		).WithKind(testerror.ValueMismatch).WithReference(o.Reference)
 	}
 	return nil
 }
+
+// isUnknown returns true if the value, or any part of it, will not be known until after apply.
+func (o Operative) isUnknown() bool {
+	return o.Unknown || ContainsUnknown(o.AfterUnknown)
+}
+
+// ContainsUnknown returns true if the supplied after_unknown value from the plan is, or contains, a true value,
+// meaning the value, or part of it, will not be known until after apply.
+func ContainsUnknown(afterUnknown any) bool {
+	switch val := afterUnknown.(type) {
+	case bool:
+		return val
+	case []any:
+		for _, e := range val {
+			if ContainsUnknown(e) {
+				return true
+			}
+		}
+	case map[string]any:
+		for _, e := range val {
+			if ContainsUnknown(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// record reports the outcome of an assertion to the Reporter, see testerror.SetReporter.
+// Assertions defer it with a pointer to their named result, so that it is read on return.
+func (o Operative) record(check string, expected any, start time.Time, result **testerror.Error) {
+	if o.quiet {
+		return
+	}
+	testerror.Record(check, o.Reference, expected, o.Actual, start, *result)
+}
+
+// quietly returns a copy of the Operative whose assertions are not reported,
+// for use when one assertion is implemented using another.
+func (o Operative) quietly() Operative {
+	o.quiet = true
+	return o
+}
+
+// mismatchf returns a ValueMismatch *testerror.Error for the Operative, recording the expected and actual values.
+func mismatchf(o Operative, expected any, format string, args ...any) *testerror.Error {
+	return testerror.Newf(format, args...).
+		WithKind(testerror.ValueMismatch).
+		WithReference(o.Reference).
+		WithValues(expected, o.Actual)
+}
+
+// typeMismatchf returns a TypeMismatch *testerror.Error for the Operative, recording the actual value.
+func typeMismatchf(o Operative, format string, args ...any) *testerror.Error {
+	return testerror.Newf(format, args...).
+		WithKind(testerror.TypeMismatch).
+		WithReference(o.Reference).
+		WithValues(nil, o.Actual)
+}
+
+// jsonBytes returns the JSON representation of the value.
+// If the value is a string, we assume it is JSON already.
+// Otherwise, we marshal it to JSON, returning nil if this fails.
+func jsonBytes(v any) []byte {
+	switch val := v.(type) {
+	case string:
+		return []byte(val)
+	case []byte:
+		return val
+	case json.RawMessage:
+		return val
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
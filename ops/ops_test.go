@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/terratest-terraform-fluent/testerror"
 	"github.com/Azure/terratest-terraform-fluent/to"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -85,6 +86,7 @@ func TestQuery(t *testing.T) {
 		mock := mockOperativeType("invalid json")
 		err := mock.Query(".").HasValue("nil").AsError()
 		assert.ErrorContains(t, err, "not valid JSON")
+		assert.ErrorIs(t, err, testerror.InvalidJSON)
 	})
 }
 
@@ -231,6 +233,13 @@ func TestHasValue(t *testing.T) {
 		mock := mockOperativeType(actual)
 		err := mock.HasValue("not_test").AsError()
 		assert.ErrorContains(t, err, "expected value not_test not equal to actual test")
+		assert.ErrorIs(t, err, testerror.ValueMismatch)
+		var te *testerror.Error
+		require.ErrorAs(t, err, &te)
+		assert.Equal(t, "test_resource.test_key", te.Reference)
+		assert.Equal(t, "not_test", te.Expected)
+		assert.Equal(t, "test", te.Actual)
+		assert.Regexp(t, `^ops_test\.go:\d+$`, te.Location())
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
@@ -240,6 +249,7 @@ func TestHasValue(t *testing.T) {
 		mock.Exist = false
 		err := mock.HasValue("not_test").AsError()
 		assert.ErrorContains(t, err, "test_resource.test_key: not found when expected")
+		assert.ErrorIs(t, err, testerror.NotFound)
 	})
 
 	t.Run("InvalidArgs", func(t *testing.T) {
@@ -358,3 +368,116 @@ func mockOperativeType(val any) Operative {
 		Exist:     true,
 	}
 }
+
+func TestUnknown(t *testing.T) {
+	t.Parallel()
+
+	unknown := mockOperativeType(any(nil))
+	unknown.Unknown = true
+
+	t.Run("IsUnknown", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, unknown.IsUnknown().AsError())
+		err := mockOperativeType("test").IsUnknown().AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: expected value to be unknown until apply, got test")
+	})
+
+	t.Run("IsKnown", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mockOperativeType("test").IsKnown().AsError())
+		err := unknown.IsKnown().AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value is unknown until apply")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(any(nil))
+		mock.Exist = false
+		assert.ErrorContains(t, mock.IsUnknown().AsError(), "not found when expected")
+		assert.ErrorContains(t, mock.IsKnown().AsError(), "not found when expected")
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, unknown.Exists().AsError())
+	})
+
+	t.Run("HasValue", func(t *testing.T) {
+		t.Parallel()
+		err := unknown.HasValue("test").AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value is unknown until apply, expected test")
+	})
+
+	t.Run("ContainsString", func(t *testing.T) {
+		t.Parallel()
+		err := unknown.ContainsString("test").AsError()
+		assert.ErrorContains(t, err, "value is unknown until apply")
+	})
+
+	t.Run("Kind", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorIs(t, unknown.HasValue("test").AsError(), testerror.ValueMismatch)
+		assert.ErrorIs(t, unknown.ContainsString("test").AsError(), testerror.ValueMismatch)
+		assert.ErrorIs(t, unknown.IsKnown().AsError(), testerror.ValueMismatch)
+		_, err := unknown.GetValue()
+		assert.ErrorIs(t, err, testerror.ValueMismatch)
+	})
+
+	t.Run("GetValue", func(t *testing.T) {
+		t.Parallel()
+		_, err := unknown.GetValue()
+		assert.ErrorContains(t, err, "value is unknown until apply")
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		t.Parallel()
+		o := unknown.Query("test")
+		assert.True(t, o.Unknown)
+		assert.ErrorContains(t, o.HasValue("test").AsError(), "test_resource.test_key?test: value is unknown until apply")
+	})
+}
+
+func TestUnknownNested(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType([]any{
+		map[string]any{"type": "SystemAssigned"},
+		map[string]any{"type": "UserAssigned", "principal_id": "abc"},
+	})
+	mock.AfterUnknown = []any{map[string]any{"principal_id": true}, map[string]any{}}
+
+	t.Run("IsKnown", func(t *testing.T) {
+		t.Parallel()
+		assert.ErrorContains(t, mock.IsKnown().AsError(), "test_resource.test_key: value is unknown until apply")
+		assert.NoError(t, mock.IsUnknown().AsError())
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		t.Parallel()
+		o := mock.Query("0.principal_id")
+		assert.True(t, o.Exist)
+		assert.True(t, o.Unknown)
+		assert.ErrorContains(t, o.HasValue("abc").AsError(), "test_resource.test_key?0.principal_id: value is unknown until apply")
+		assert.NoError(t, mock.Query("0.type").HasValue("SystemAssigned").AsError())
+		assert.NoError(t, mock.Query("1.principal_id").HasValue("abc").AsError())
+	})
+
+	t.Run("ForEach", func(t *testing.T) {
+		t.Parallel()
+		err := mock.ForEach(func(_ int, el Operative) *testerror.Error {
+			return el.Query("principal_id").IsKnown()
+		}).AsError()
+		assert.ErrorContains(t, err, "1 of 2 elements failed")
+		assert.ErrorContains(t, err, "test_resource.test_key[0]?principal_id: value is unknown until apply")
+	})
+}
+
+func TestContainsUnknown(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ContainsUnknown(true))
+	assert.True(t, ContainsUnknown([]any{map[string]any{"principal_id": true}}))
+	assert.False(t, ContainsUnknown(false))
+	assert.False(t, ContainsUnknown(nil))
+	assert.False(t, ContainsUnknown(map[string]any{"tags": map[string]any{}, "ids": []any{false}}))
+}
@@ -0,0 +1,83 @@
+package ops
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// regexCache caches compiled regular expressions, keyed by pattern,
+// as the same naming convention patterns are typically checked many times in a test run.
+var regexCache sync.Map
+
+// MatchesRegex returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key does not match the regular expression pattern.
+func (o Operative) MatchesRegex(pattern string) (result *testerror.Error) {
+	defer o.record("MatchesRegex", pattern, time.Now(), &result)
+	actual, re, err := o.stringAndRegex(pattern)
+	if err != nil {
+		return err
+	}
+	if !re.MatchString(actual) {
+		return mismatchf(
+			o,
+			pattern,
+			"%s: value %s does not match pattern %s",
+			o.Reference,
+			actual,
+			pattern,
+		)
+	}
+	return nil
+}
+
+// DoesNotMatchRegex returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key matches the regular expression pattern.
+func (o Operative) DoesNotMatchRegex(pattern string) (result *testerror.Error) {
+	defer o.record("DoesNotMatchRegex", pattern, time.Now(), &result)
+	actual, re, err := o.stringAndRegex(pattern)
+	if err != nil {
+		return err
+	}
+	if re.MatchString(actual) {
+		return mismatchf(
+			o,
+			pattern,
+			"%s: value %s matches pattern %s",
+			o.Reference,
+			actual,
+			pattern,
+		)
+	}
+	return nil
+}
+
+func (o Operative) stringAndRegex(pattern string) (string, *regexp.Regexp, *testerror.Error) {
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return "", nil, err
+	}
+	re, err := compileRegex(pattern)
+	if err != nil {
+		return "", nil, testerror.Newf("invalid pattern %s: %v", pattern, err).Wrap(err)
+	}
+	actual, ok := o.Actual.(string)
+	if !ok {
+		return "", nil, typeMismatchf(o, "Cannot convert value to string: %s", o.Reference)
+	}
+	return actual, re, nil
+}
+
+// compileRegex returns the compiled regular expression for the pattern, using the cache if possible.
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, re)
+	return re, nil
+}
@@ -0,0 +1,81 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesRegex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("rg-test-weu-001")
+		assert.NoError(t, mock.MatchesRegex(`^rg-[a-z]+-[a-z]{3}-\d{3}$`).AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("test-rg")
+		err := mock.MatchesRegex(`^rg-`).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value test-rg does not match pattern ^rg-")
+	})
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("test")
+		err := mock.MatchesRegex(`[`).AsError()
+		assert.ErrorContains(t, err, "invalid pattern [: error parsing regexp")
+	})
+
+	t.Run("NotAString", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType(1)
+		err := mock.MatchesRegex(`1`).AsError()
+		assert.ErrorContains(t, err, "Cannot convert value to string")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("test")
+		mock.Exist = false
+		err := mock.MatchesRegex(`test`).AsError()
+		assert.ErrorContains(t, err, "not found when expected")
+	})
+}
+
+func TestDoesNotMatchRegex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("rg-test")
+		assert.NoError(t, mock.DoesNotMatchRegex(`[A-Z]`).AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("rg-Test")
+		err := mock.DoesNotMatchRegex(`[A-Z]`).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value rg-Test matches pattern [A-Z]")
+	})
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		t.Parallel()
+		mock := mockOperativeType("test")
+		err := mock.DoesNotMatchRegex(`(`).AsError()
+		assert.ErrorContains(t, err, "invalid pattern (")
+	})
+}
+
+func TestCompileRegexCache(t *testing.T) {
+	t.Parallel()
+
+	re1, err := compileRegex(`^cache-test$`)
+	require.NoError(t, err)
+	re2, err := compileRegex(`^cache-test$`)
+	require.NoError(t, err)
+	assert.Same(t, re1, re2)
+}
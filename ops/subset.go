@@ -0,0 +1,100 @@
+package ops
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+)
+
+// MatchesSubset returns a non-nil *testerror.Error if the resource does not exist in the plan or if
+// the value of the key does not contain the expected keys and values.
+// Maps are compared recursively using only the keys that are present in expected,
+// lists are compared element by element for the number of elements in expected.
+// All mismatches are reported, each qualified with its path.
+func (o Operative) MatchesSubset(expected map[string]any) (result *testerror.Error) {
+	defer o.record("MatchesSubset", expected, time.Now(), &result)
+	if err := isErrorOrNotExistOrUnknown(o); err != nil {
+		return err
+	}
+	exp, err := normalise(expected)
+	if err != nil {
+		return testerror.Newf("invalid operation: %#v (%s)", expected, err).Wrap(err)
+	}
+	errs := subsetErrors(o.Reference, exp, o.Actual)
+	return testerror.Join(fmt.Sprintf("%s: value does not match subset", o.Reference), errs).
+		WithKind(testerror.ValueMismatch).
+		WithReference(o.Reference)
+}
+
+// MatchesSubset returns a non-nil *testerror.Error if the value of the key contains the expected keys and values
+func (n NotType) MatchesSubset(expected map[string]any) (result *testerror.Error) {
+	defer n.record("Not.MatchesSubset", expected, time.Now(), &result)
+	return n.negate(n.o.MatchesSubset(expected), "expected value not to match subset %v", expected)
+}
+
+// subsetErrors returns an error for every path in expected whose value does not match actual.
+func subsetErrors(path string, expected, actual any) []*testerror.Error {
+	switch exp := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			return []*testerror.Error{subsetMismatchf(path, expected, actual, "%s: expected map, got %s", path, describe(actual))}
+		}
+		errs := make([]*testerror.Error, 0)
+		for _, k := range sortedKeys(exp) {
+			p := fmt.Sprintf("%s.%s", path, k)
+			v, ok := act[k]
+			if !ok {
+				errs = append(errs, subsetMismatchf(p, exp[k], nil, "%s: not found when expected", p))
+				continue
+			}
+			errs = append(errs, subsetErrors(p, exp[k], v)...)
+		}
+		return errs
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			return []*testerror.Error{subsetMismatchf(path, expected, actual, "%s: expected list, got %s", path, describe(actual))}
+		}
+		errs := make([]*testerror.Error, 0)
+		for i, e := range exp {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			if i >= len(act) {
+				errs = append(errs, subsetMismatchf(p, e, nil, "%s: not found when expected", p))
+				continue
+			}
+			errs = append(errs, subsetErrors(p, e, act[i])...)
+		}
+		return errs
+	}
+	if !assert.ObjectsAreEqualValues(expected, actual) {
+		return []*testerror.Error{subsetMismatchf(path, expected, actual, "%s", mismatchMessage(path, expected, actual))}
+	}
+	return nil
+}
+
+// subsetMismatchf returns a ValueMismatch *testerror.Error for the path within a subset.
+// A missing or differently typed value is a mismatch of the subset, rather than an error in the assertion.
+func subsetMismatchf(path string, expected, actual any, format string, args ...any) *testerror.Error {
+	return testerror.Newf(format, args...).
+		WithKind(testerror.ValueMismatch).
+		WithReference(path).
+		WithValues(expected, actual)
+}
+
+// normalise converts the value to its JSON representation using maps, slices, strings, float64s and bools,
+// so values supplied as Go types can be compared with values decoded from the plan.
+func normalise(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res any
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
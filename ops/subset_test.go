@@ -0,0 +1,101 @@
+package ops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesSubset(t *testing.T) {
+	t.Parallel()
+
+	mock := mockOperativeType(map[string]any{
+		"always_on":     true,
+		"ftps_state":    "Disabled",
+		"worker_count":  float64(2),
+		"default_value": "provider default",
+		"ip_restriction": []any{
+			map[string]any{"name": "allow", "priority": float64(100), "action": "Allow"},
+			map[string]any{"name": "deny", "priority": float64(200), "action": "Deny"},
+		},
+		"tags": map[string]any{"env": "prod", "owner": "me"},
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		err := mock.MatchesSubset(map[string]any{
+			"always_on":    true,
+			"worker_count": 2,
+			"ip_restriction": []map[string]any{
+				{"name": "allow"},
+				{"action": "Deny"},
+			},
+			"tags": map[string]string{"env": "prod"},
+		})
+		assert.NoError(t, err.AsError())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		t.Parallel()
+		err := mock.MatchesSubset(map[string]any{
+			"always_on":  false,
+			"http2":      true,
+			"ftps_state": map[string]any{"value": "Disabled"},
+			"ip_restriction": []any{
+				map[string]any{"priority": 100},
+				map[string]any{"action": "Allow"},
+				map[string]any{"name": "extra"},
+			},
+			"tags": []any{"env"},
+		}).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: value does not match subset")
+		assert.ErrorContains(t, err, "test_resource.test_key.always_on: expected value false not equal to actual true")
+		assert.ErrorContains(t, err, "test_resource.test_key.http2: not found when expected")
+		assert.ErrorContains(t, err, "test_resource.test_key.ftps_state: expected map, got Disabled")
+		assert.ErrorContains(t, err, "test_resource.test_key.ip_restriction[1].action: expected value Allow not equal to actual Deny")
+		assert.ErrorContains(t, err, "test_resource.test_key.ip_restriction[2]: not found when expected")
+		assert.ErrorContains(t, err, "test_resource.test_key.tags: expected list, got map")
+		assert.NotContains(t, err.Error(), "ip_restriction[0]")
+	})
+
+	t.Run("CompositeActual", func(t *testing.T) {
+		t.Parallel()
+		err := mock.MatchesSubset(map[string]any{"ip_restriction": "none", "tags": map[string]any{"env": []any{"prod"}}}).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key.ip_restriction: expected value not equal to actual (- expected, + actual):")
+		assert.ErrorContains(t, err, `~ .: "none" => [`)
+		assert.ErrorContains(t, err, "test_resource.test_key.tags.env: expected list, got prod")
+	})
+
+	t.Run("NotAMap", func(t *testing.T) {
+		t.Parallel()
+		err := mockOperativeType("test").MatchesSubset(map[string]any{"a": 1}).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: expected map, got test")
+	})
+
+	t.Run("InvalidExpected", func(t *testing.T) {
+		t.Parallel()
+		err := mock.MatchesSubset(map[string]any{"a": func() {}}).AsError()
+		assert.ErrorContains(t, err, "invalid operation")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		m := mockOperativeType(any(nil))
+		m.Exist = false
+		assert.ErrorContains(t, m.MatchesSubset(map[string]any{}).AsError(), "not found when expected")
+	})
+
+	t.Run("Not", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, mock.Not().MatchesSubset(map[string]any{"always_on": false}).AsError())
+		err := mock.Not().MatchesSubset(map[string]any{"always_on": true}).AsError()
+		assert.ErrorContains(t, err, "test_resource.test_key: expected value not to match subset map[always_on:true]")
+	})
+}
+
+func TestNotMatchesSubsetInvalidExpected(t *testing.T) {
+	t.Parallel()
+
+	o := mockOperativeType(map[string]any{"a": "b"})
+	assert.ErrorContains(t, o.Not().MatchesSubset(map[string]any{"a": func() {}}).AsError(), "invalid operation")
+}
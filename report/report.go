@@ -0,0 +1,214 @@
+// Package report records the outcome of every check made through the check, ops and setuptest packages,
+// and writes a machine-readable report in JSON and JUnit XML formats when the test binary finishes.
+//
+// Reporting is enabled from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(report.Run(m, report.Options{
+//			JSONFile:  "assertions.json",
+//			JUnitFile: "assertions.xml",
+//		}))
+//	}
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+)
+
+// Options configures the files written by Run.
+// A report is not written if its file name is empty.
+type Options struct {
+	JSONFile  string
+	JUnitFile string
+}
+
+// Recorder is a testerror.Reporter which keeps the Result of every check in memory.
+// It is safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	results []testerror.Result
+}
+
+// New returns a new, empty, Recorder.
+func New() *Recorder {
+	return &Recorder{
+		results: make([]testerror.Result, 0),
+	}
+}
+
+// Run sets a new Recorder as the testerror Reporter, runs the tests and then writes the reports.
+// It returns the exit code to pass to os.Exit, which is non-zero if the tests fail or the reports cannot be written.
+func Run(m *testing.M, opts Options) int {
+	r := New()
+	testerror.SetReporter(r)
+	code := m.Run()
+	testerror.SetReporter(nil)
+	if err := r.WriteFiles(opts); err != nil {
+		fmt.Fprintf(os.Stderr, "writing assertion report: %v\n", err)
+		if code == 0 {
+			code = 1
+		}
+	}
+	return code
+}
+
+// Record implements testerror.Reporter.
+func (r *Recorder) Record(res testerror.Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, res)
+}
+
+// Results returns the Result of every check recorded so far, in the order they were made.
+func (r *Recorder) Results() []testerror.Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := make([]testerror.Result, len(r.results))
+	copy(res, r.results)
+	return res
+}
+
+// WriteFiles writes the JSON and JUnit XML reports to the files in opts.
+func (r *Recorder) WriteFiles(opts Options) error {
+	if opts.JSONFile != "" {
+		if err := writeFile(opts.JSONFile, r.WriteJSON); err != nil {
+			return err
+		}
+	}
+	if opts.JUnitFile != "" {
+		if err := writeFile(opts.JUnitFile, r.WriteJUnit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonReport is the document written by WriteJSON.
+type jsonReport struct {
+	Total   int                `json:"total"`
+	Passed  int                `json:"passed"`
+	Failed  int                `json:"failed"`
+	Results []testerror.Result `json:"results"`
+}
+
+// WriteJSON writes the recorded results as a JSON document with a summary of the number of checks.
+// Durations are in nanoseconds.
+func (r *Recorder) WriteJSON(w io.Writer) error {
+	results := r.Results()
+	failed := countFailed(results)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(jsonReport{
+		Total:   len(results),
+		Passed:  len(results) - failed,
+		Failed:  failed,
+		Results: results,
+	})
+}
+
+// junitTestSuites is the root element written by WriteJUnit.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the recorded results as a JUnit XML document, with a single test suite
+// containing one test case per check, named after the check and classified by its Reference.
+// The file and line attributes are the call site of the check, if known.
+func (r *Recorder) WriteJUnit(w io.Writer) error {
+	results := r.Results()
+	suite := junitTestSuite{
+		Name:     "terratest-terraform-fluent",
+		Tests:    len(results),
+		Failures: countFailed(results),
+		Cases:    make([]junitTestCase, len(results)),
+	}
+	var total float64
+	for i, res := range results {
+		total += res.Duration.Seconds()
+		tc := junitTestCase{
+			ClassName: res.Reference,
+			Name:      res.Check,
+			File:      res.File,
+			Line:      res.Line,
+			Time:      seconds(res.Duration.Seconds()),
+		}
+		if !res.Passed {
+			tc.Failure = &junitFailure{
+				Message: res.Message,
+				Type:    res.Kind,
+				Text:    fmt.Sprintf("%s\nexpected: %s\nactual: %s", res.Message, res.Expected, res.Actual),
+			}
+		}
+		suite.Cases[i] = tc
+	}
+	suite.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func countFailed(results []testerror.Result) int {
+	failed := 0
+	for _, res := range results {
+		if !res.Passed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func seconds(s float64) string {
+	return fmt.Sprintf("%.6f", s)
+}
+
+func writeFile(name string, write func(io.Writer) error) error {
+	f, err := os.Create(name) // #nosec G304 -- name is supplied by the test author
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,148 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/terratest-terraform-fluent/ops"
+	"github.com/Azure/terratest-terraform-fluent/testerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockRecorder() *Recorder {
+	r := New()
+	r.Record(testerror.Result{
+		Check:     "HasValue",
+		Reference: "azurerm_resource_group.this.location",
+		Passed:    true,
+		Expected:  "westeurope",
+		Actual:    "westeurope",
+		Duration:  time.Millisecond,
+	})
+	r.Record(testerror.Result{
+		Check:     "HasValue",
+		Reference: "azurerm_key_vault.this.sku_name",
+		Kind:      "value mismatch",
+		Message:   "azurerm_key_vault.this.sku_name: expected value standard not equal to actual premium",
+		Expected:  "standard",
+		Actual:    "premium",
+		File:      "/src/main_test.go",
+		Line:      42,
+		Duration:  time.Millisecond,
+	})
+	return r
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, mockRecorder().WriteJSON(&buf))
+
+	var rpt jsonReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rpt))
+	assert.Equal(t, 2, rpt.Total)
+	assert.Equal(t, 1, rpt.Passed)
+	assert.Equal(t, 1, rpt.Failed)
+	assert.Equal(t, mockRecorder().Results(), rpt.Results)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, mockRecorder().WriteJUnit(&buf))
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+	require.Len(t, suites.Suites, 1)
+	s := suites.Suites[0]
+	assert.Equal(t, 2, s.Tests)
+	assert.Equal(t, 1, s.Failures)
+	assert.Equal(t, "0.002000", s.Time)
+	require.Len(t, s.Cases, 2)
+	assert.Nil(t, s.Cases[0].Failure)
+	assert.Equal(t, "azurerm_key_vault.this.sku_name", s.Cases[1].ClassName)
+	assert.Equal(t, "HasValue", s.Cases[1].Name)
+	assert.Equal(t, "/src/main_test.go", s.Cases[1].File)
+	assert.Equal(t, 42, s.Cases[1].Line)
+	require.NotNil(t, s.Cases[1].Failure)
+	assert.Equal(t, "value mismatch", s.Cases[1].Failure.Type)
+	assert.Contains(t, s.Cases[1].Failure.Text, "expected: standard\nactual: premium")
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		JSONFile:  filepath.Join(dir, "report.json"),
+		JUnitFile: filepath.Join(dir, "report.xml"),
+	}
+	require.NoError(t, mockRecorder().WriteFiles(opts))
+	assert.FileExists(t, opts.JSONFile)
+	assert.FileExists(t, opts.JUnitFile)
+}
+
+func TestWriteFilesNone(t *testing.T) {
+	assert.NoError(t, mockRecorder().WriteFiles(Options{}))
+}
+
+func TestWriteFilesFail(t *testing.T) {
+	err := mockRecorder().WriteFiles(Options{
+		JSONFile: filepath.Join(t.TempDir(), "not_exists", "report.json"),
+	})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// TestRecordOps is not run in parallel, as the reporter is global.
+func TestRecordOps(t *testing.T) {
+	r := New()
+	testerror.SetReporter(r)
+	defer testerror.SetReporter(nil)
+
+	o := ops.Operative{
+		Reference: "azurerm_storage_account.this.min_tls_version",
+		Actual:    "TLS1_2",
+		Exist:     true,
+	}
+	_ = o.HasValue("TLS1_2")
+	_ = o.Not().HasValue("TLS1_2")
+	_ = o.Not().HasValue("TLS1_0")
+
+	res := r.Results()
+	require.Len(t, res, 3)
+	assert.Equal(t, "HasValue", res[0].Check)
+	assert.True(t, res[0].Passed)
+	assert.Equal(t, "Not.HasValue", res[1].Check)
+	assert.False(t, res[1].Passed)
+	assert.Equal(t, "value mismatch", res[1].Kind)
+	assert.Equal(t, "Not.HasValue", res[2].Check)
+	assert.True(t, res[2].Passed)
+	assert.Equal(t, "TLS1_0", res[2].Expected)
+	assert.Equal(t, "TLS1_2", res[2].Actual)
+	for _, r := range res {
+		assert.Equal(t, "report_test.go", filepath.Base(r.File))
+	}
+}
+
+// TestRecordNested checks that assertions implemented using other assertions are recorded once.
+func TestRecordNested(t *testing.T) {
+	r := New()
+	testerror.SetReporter(r)
+	defer testerror.SetReporter(nil)
+
+	o := ops.Operative{
+		Reference: "azurerm_kubernetes_cluster.this.default_node_pool.0.max_count",
+		Actual:    float64(5),
+		Exist:     true,
+	}
+	_ = o.Between(3, 10)
+	_ = o.Not().Between(3, 10)
+
+	res := r.Results()
+	require.Len(t, res, 2)
+	assert.Equal(t, "Between", res[0].Check)
+	assert.Equal(t, "[3 10]", res[0].Expected)
+	assert.Equal(t, "Not.Between", res[1].Check)
+}
@@ -42,48 +42,65 @@ var SlowRetry = Retry{
 
 // Apply runs terraform apply for the given Response and returns the error.
 // If the plan file does not exist, it will run terraform apply without a plan file.
-func (resp Response) Apply() *testerror.Error {
+func (resp Response) Apply() (result *testerror.Error) {
+	defer resp.record("Apply", time.Now(), &result)
 	opts, err := checkPlanFileExists(resp.Options)
 	if err != nil {
-		return testerror.New(err.Error())
+		return testerror.New(err.Error()).Wrap(err)
 	}
 	_, err = terraform.ApplyE(resp.t, opts)
 	if err != nil {
-		return testerror.New(err.Error())
+		return commandFailed(err)
 	}
 	return nil
 }
 
 // Apply runs terraform apply, then plan for the given Response and checks for any changes,
 // it then returns the error.
+// The error is of kind testerror.NotIdempotent if the plan contains changes,
+// or testerror.TerraformCommandFailed if either command fails.
 // If the plan file does not exist, it will run terraform apply without a plan file.
-func (resp Response) ApplyIdempotent() *testerror.Error {
+func (resp Response) ApplyIdempotent() (result *testerror.Error) {
+	defer resp.record("ApplyIdempotent", time.Now(), &result)
 	opts, err := checkPlanFileExists(resp.Options)
 	if err != nil {
-		return testerror.New(err.Error())
+		return testerror.New(err.Error()).Wrap(err)
 	}
-	_, err = terraform.ApplyAndIdempotentE(resp.t, opts)
+	_, err = terraform.ApplyE(resp.t, opts)
 	if err != nil {
-		return testerror.New(err.Error())
+		return commandFailed(err)
+	}
+	exitCode, err := terraform.PlanExitCodeE(resp.t, opts)
+	if err != nil {
+		return commandFailed(err)
+	}
+	switch exitCode {
+	case 0:
+		return nil
+	case 2:
+		return testerror.New("terraform configuration not idempotent").WithKind(testerror.NotIdempotent)
+	default:
+		return testerror.New("terraform plan error").WithKind(testerror.TerraformCommandFailed)
 	}
-	return nil
 }
 
 // Apply runs terraform apply, then performs a retry loop with a plan.
 // If the configuration is not idempotent, it will retry up to the specified number of times.
 // It then returns the error.
 // If the plan file does not exist, it will run terraform apply without a plan file.
-func (resp Response) ApplyIdempotentRetry(r Retry) *testerror.Error {
+func (resp Response) ApplyIdempotentRetry(r Retry) (result *testerror.Error) {
+	defer resp.record("ApplyIdempotentRetry", time.Now(), &result)
 	opts, err := checkPlanFileExists(resp.Options)
 	if err != nil {
-		return testerror.New(err.Error())
+		return testerror.New(err.Error()).Wrap(err)
 	}
 
 	_, err = terraform.ApplyE(resp.t, opts)
 	if err != nil {
-		return testerror.New(err.Error())
+		return commandFailed(err)
 	}
 
+	kind := testerror.TerraformCommandFailed
 	_, err = retry.DoWithRetryE(resp.t, "terraform plan", r.Max, r.Wait, func() (string, error) {
 		exitCode, err := terraform.PlanExitCodeE(resp.t, opts)
 		if err != nil {
@@ -93,6 +110,7 @@ func (resp Response) ApplyIdempotentRetry(r Retry) *testerror.Error {
 		case 0:
 			return "", nil
 		case 2:
+			kind = testerror.NotIdempotent
 			return "", retry.FatalError{Underlying: errors.New("terraform configuration not idempotent")}
 		default:
 			return "", errors.New("terraform plan error")
@@ -100,12 +118,23 @@ func (resp Response) ApplyIdempotentRetry(r Retry) *testerror.Error {
 	})
 
 	if err != nil {
-		return testerror.New(err.Error())
+		return testerror.New(err.Error()).WithKind(kind).Wrap(err)
 	}
 
 	return nil
 }
 
+// record reports the outcome of a Terraform command to the Reporter, see testerror.SetReporter.
+// The Reference is the directory in which the command is run.
+func (resp Response) record(check string, start time.Time, result **testerror.Error) {
+	testerror.Record(check, resp.Options.TerraformDir, nil, nil, start, *result)
+}
+
+// commandFailed returns a *testerror.Error of kind testerror.TerraformCommandFailed, wrapping the error from terratest.
+func commandFailed(err error) *testerror.Error {
+	return testerror.New(err.Error()).WithKind(testerror.TerraformCommandFailed).Wrap(err)
+}
+
 // checkPlanFileExists takes in a terraform.Options and checks if the plan file exists.
 // If it does not it returns a new terraform.Options with the PlanFilePath set to "" (to enable apply to be run without a plan file).
 // If it does exist, it returns the original terraform.Options.
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Azure/terratest-terraform-fluent/testerror"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -52,6 +53,7 @@ func TestApplyIdempotentRetryFail(t *testing.T) {
 	err = test.ApplyIdempotentRetry(rty).AsError()
 	assert.Truef(t, time.Since(tb) >= 10*time.Second, "retry should have waited at least 10 second")
 	assert.ErrorContains(t, err, "'terraform plan' unsuccessful after 2 retries")
+	assert.ErrorIs(t, err, testerror.TerraformCommandFailed)
 }
 
 func TestApplyFail(t *testing.T) {
@@ -62,6 +64,7 @@ func TestApplyFail(t *testing.T) {
 	require.NoError(t, err)
 	err = test.Apply().AsError()
 	assert.ErrorContains(t, err, "test error")
+	assert.ErrorIs(t, err, testerror.TerraformCommandFailed)
 }
 
 func TestApplyIdempotentApplyFail(t *testing.T) {
@@ -72,6 +75,7 @@ func TestApplyIdempotentApplyFail(t *testing.T) {
 	require.NoError(t, err)
 	err = test.ApplyIdempotent().AsError()
 	assert.ErrorContains(t, err, "test error")
+	assert.ErrorIs(t, err, testerror.TerraformCommandFailed)
 }
 
 func TestApplyIdempotentRetryApplyFail(t *testing.T) {
@@ -1,21 +1,25 @@
 package setuptest
 
 import (
+	"time"
+
 	"github.com/Azure/terratest-terraform-fluent/testerror"
 	"github.com/gruntwork-io/terratest/modules/terraform"
 )
 
 // Destroy runs terraform destroy for the given Response and returns the error.
-func (resp Response) Destroy() *testerror.Error {
+func (resp Response) Destroy() (result *testerror.Error) {
+	defer resp.record("Destroy", time.Now(), &result)
 	_, err := terraform.DestroyE(resp.t, resp.Options)
 	if err != nil {
-		return testerror.New(err.Error())
+		return commandFailed(err)
 	}
 	return nil
 }
 
 // DestroyWithRetry will retry the terraform destroy command up to the specified number of times.
-func (resp Response) DestroyRetry(r Retry) *testerror.Error {
+func (resp Response) DestroyRetry(r Retry) (result *testerror.Error) {
+	defer resp.record("DestroyRetry", time.Now(), &result)
 	resp.Options.RetryableTerraformErrors = map[string]string{
 		".*": "Retry destroy on any error",
 	}
@@ -24,7 +28,9 @@ func (resp Response) DestroyRetry(r Retry) *testerror.Error {
 	_, err := terraform.DestroyE(resp.t, resp.Options)
 
 	if err != nil {
-		return testerror.Newf("terraform destroy failed after %d attempts: %v", r.Max, err)
+		return testerror.Newf("terraform destroy failed after %d attempts: %v", r.Max, err).
+			WithKind(testerror.TerraformCommandFailed).
+			Wrap(err)
 	}
 	return nil
 }
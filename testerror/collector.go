@@ -0,0 +1,85 @@
+package testerror
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Collector accumulates the errors from many checks, so that they can be reported together,
+// rather than stopping at the first failure. This is sometimes known as soft assertions.
+//
+//	c := testerror.NewCollector(t)
+//	c.Add(check.InPlan(plan).That("azurerm_resource_group.this").Key("location").HasValue("westeurope"))
+//	c.Add(check.InPlan(plan).That("azurerm_resource_group.this").Key("name").ContainsString("rg-"))
+//
+// Any errors collected are reported when the test finishes, or earlier by calling Report.
+// It is safe for concurrent use.
+type Collector struct {
+	t     TestingT
+	mu    sync.Mutex
+	total int
+	errs  []*Error
+}
+
+// NewCollector returns a new Collector which reports any collected errors when the test finishes.
+// If t does not have a Cleanup(func()) method, as testing.TB does, Report must be called explicitly.
+func NewCollector(t TestingT) *Collector {
+	// Failures in cleanup functions are attributed to the caller that registered them, skipping helpers.
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	c := &Collector{
+		t: t,
+	}
+	if cl, ok := t.(interface{ Cleanup(func()) }); ok {
+		cl.Cleanup(c.Report)
+	}
+	return c
+}
+
+// Add records the results of one or more checks, nil errors are counted as passed checks.
+func (c *Collector) Add(errs ...*Error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range errs {
+		c.total++
+		if e != nil {
+			c.errs = append(c.errs, e)
+		}
+	}
+}
+
+// Errors returns the errors collected since the last report.
+func (c *Collector) Errors() []*Error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := make([]*Error, len(c.errs))
+	copy(res, c.errs)
+	return res
+}
+
+// Err returns a single *Error combining all errors collected since the last report,
+// or nil if no checks have failed.
+func (c *Collector) Err() *Error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err()
+}
+
+// Report fails the test with all errors collected since the last report, then resets the Collector.
+func (c *Collector) Report() {
+	if h, ok := c.t.(tHelper); ok {
+		h.Helper()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.err(); err != nil {
+		c.t.Error(err.msg)
+	}
+	c.total = 0
+	c.errs = nil
+}
+
+func (c *Collector) err() *Error {
+	return Join(fmt.Sprintf("%d of %d checks failed", len(c.errs), c.total), c.errs)
+}
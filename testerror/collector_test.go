@@ -0,0 +1,89 @@
+package testerror
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector(t)
+	c.Add(nil, nil)
+	c.Add(nil)
+	assert.Empty(t, c.Errors())
+	assert.Nil(t, c.Err())
+}
+
+func TestCollectorFail(t *testing.T) {
+	var t1 testing.T
+	c := &Collector{t: &t1}
+	first, second := New("a.b: first"), New("c.d: second")
+	c.Add(first, nil)
+	c.Add(nil, second)
+	assert.Len(t, c.Errors(), 2)
+	assert.Equal(t, "2 of 4 checks failed\n  "+first.Error()+"\n  "+second.Error(), c.Err().Message())
+	c.Report()
+	assert.True(t, t1.Failed())
+	assert.Empty(t, c.Errors())
+	assert.Nil(t, c.Err())
+}
+
+func TestCollectorConcurrent(t *testing.T) {
+	var t1 testing.T
+	c := &Collector{t: &t1}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Add(New("error"))
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, c.Errors(), 10)
+}
+
+func TestCollectorTestingT(t *testing.T) {
+	m := &mockT{}
+	c := NewCollector(m)
+	c.Add(New("error"))
+	assert.False(t, m.failed)
+	c.Report()
+	assert.True(t, m.failed)
+}
+
+// cleanupT is a TestingT which records helper calls and runs cleanup functions on demand, as testing.T does.
+type cleanupT struct {
+	mockT
+	helpers  int
+	cleanups []func()
+}
+
+func (m *cleanupT) Helper()          { m.helpers++ }
+func (m *cleanupT) Cleanup(f func()) { m.cleanups = append(m.cleanups, f) }
+func (m *cleanupT) runCleanups() {
+	for _, f := range m.cleanups {
+		f()
+	}
+}
+
+func TestNewCollectorReportsOnCleanup(t *testing.T) {
+	m := &cleanupT{}
+	c := NewCollector(m)
+	assert.Equal(t, 1, m.helpers)
+	assert.Len(t, m.cleanups, 1)
+	c.Add(nil, New("a.b: failed"))
+	assert.False(t, m.failed)
+	m.runCleanups()
+	assert.True(t, m.failed)
+	assert.Empty(t, c.Errors())
+}
+
+func TestNewCollectorCleanupPasses(t *testing.T) {
+	m := &cleanupT{}
+	c := NewCollector(m)
+	c.Add(nil)
+	m.runCleanups()
+	assert.False(t, m.failed)
+}
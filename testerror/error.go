@@ -4,77 +4,217 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"testing"
 )
 
+// TestingT is the minimal interface required by the assertion helpers in this package.
+// It is satisfied by *testing.T, *testing.B, *testing.F, testing.TB and terratest's testing.TestingT.
+// If the implementation also has a Helper() method, as testing.TB does, it is called so that
+// failures are reported at the caller's line.
+type TestingT interface {
+	Error(args ...any)
+	Errorf(format string, args ...any)
+	Fatal(args ...any)
+}
+
+// tHelper is implemented by testing.TB, it is used to mark the assertion helpers as such.
+type tHelper interface {
+	Helper()
+}
+
 // Error is a simple error type that allows us to chain checks using methods.
+// Kind, Reference, Expected and Actual describe the failure, where known, so that it can be classified
+// programmatically, e.g. using `errors.Is(err, testerror.NotFound)` or errors.As.
+// Any underlying cause is available through errors.Unwrap.
+// File and Line are the user's call site that produced the error, if known, see Location.
 // However due to Go's way of handling interface types, when a nil *Error value is used as
 // an error type (e.g. when passed into a func accepting an error) the underlying concrete
 // type is *Error and it will not pass the usual error != nil check.
 // Instead use the AsError() method to get a regular error type.
 // Or use the reflect package `val := reflect.ValueOf(myCheckError); val.IsNil()`.
 type Error struct {
-	msg string
+	Kind      Kind
+	Reference string
+	Expected  any
+	Actual    any
+	File      string
+	Line      int
+	msg       string
+	cause     error
 }
 
+// New returns a new *Error with the supplied message.
 func New(msg string) *Error {
+	file, line := caller()
 	return &Error{
-		msg: msg,
+		File: file,
+		Line: line,
+		msg:  msg,
 	}
 }
 
+// Newf returns a new *Error with a message formatted according to the format specifier.
 func Newf(format string, args ...any) *Error {
+	file, line := caller()
 	return &Error{
-		msg: fmt.Sprintf(format, args...),
+		File: file,
+		Line: line,
+		msg:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Join combines multiple errors into a single *Error, with the summary on the first line
+// and each error, including its location, on a separate indented line beneath it.
+// The errors are wrapped, so errors.Is and errors.As will match any of them.
+// It returns nil if there are no errors.
+func Join(summary string, errs []*Error) *Error {
+	if len(errs) == 0 {
+		return nil
+	}
+	var sb strings.Builder
+	sb.WriteString(summary)
+	causes := make([]error, len(errs))
+	for i, err := range errs {
+		sb.WriteString("\n  ")
+		sb.WriteString(err.Error())
+		causes[i] = err
 	}
+	return New(sb.String()).Wrap(errors.Join(causes...))
 }
 
-// Implement Error interface
+// WithKind returns a copy of the *Error with the supplied Kind.
+func (e *Error) WithKind(kind Kind) *Error {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	c.Kind = kind
+	return &c
+}
+
+// WithReference returns a copy of the *Error with the supplied Reference.
+func (e *Error) WithReference(ref string) *Error {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	c.Reference = ref
+	return &c
+}
+
+// WithValues returns a copy of the *Error with the supplied Expected and Actual values.
+func (e *Error) WithValues(expected, actual any) *Error {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	c.Expected = expected
+	c.Actual = actual
+	return &c
+}
+
+// Wrap returns a copy of the *Error with the supplied underlying cause.
+// The message is unchanged, so callers should include the cause in it if required.
+func (e *Error) Wrap(cause error) *Error {
+	if e == nil {
+		return nil
+	}
+	c := *e
+	c.cause = cause
+	return &c
+}
+
+// Unwrap returns the underlying cause of the *Error, if any.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is reports whether the *Error is of the target Kind, allowing `errors.Is(err, testerror.NotFound)`.
+func (e *Error) Is(target error) bool {
+	k, ok := target.(Kind)
+	return ok && e.Kind == k
+}
+
+// Implement Error interface.
+// The message is prefixed with the location of the call site, if known, e.g. `main_test.go:42: message`.
 func (e *Error) Error() string {
+	if loc := e.Location(); loc != "" {
+		return loc + ": " + e.msg
+	}
 	return e.msg
 }
 
+// Message returns the message of the error, without the location.
+func (e *Error) Message() string {
+	return e.msg
+}
+
+// Location returns the file and line of the call site that produced the error, e.g. `main_test.go:42`,
+// or an empty string if it is not known.
+// Frames within this module are skipped, so that it is the line in the user's test.
+func (e *Error) Location() string {
+	return location(e.File, e.Line)
+}
+
 // AsError returns a regular error type that can be used in the usual way.
 // This fixes some issues when comparing nil types, which can fail as the underlying types are different.
 // e.g. comparing the a nil error interface type to a nil *Error type from this package can fail.
+// A non-nil result still holds the *Error, so it can be inspected with errors.Is and errors.As.
 func (e *Error) AsError() error {
 	if e == nil {
 		return nil
 	}
-	return errors.New(e.msg)
+	return e
 }
 
-func (e *Error) ErrorIsNil(t *testing.T) {
+func (e *Error) ErrorIsNil(t TestingT) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if e != nil {
 		t.Error(e.msg)
 	}
 }
 
-func (e *Error) ErrorIsNilFatal(t *testing.T) {
+func (e *Error) ErrorIsNilFatal(t TestingT) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if e != nil {
 		t.Fatal(e.msg)
 	}
 }
 
-func (e *Error) ErrorNotNil(t *testing.T) {
+func (e *Error) ErrorNotNil(t TestingT) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if e == nil {
 		t.Error("error is nil")
 	}
 }
 
-func (e *Error) ErrorNotNilFatal(t *testing.T) {
+func (e *Error) ErrorNotNilFatal(t TestingT) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if e == nil {
 		t.Fatal("error is nil")
 	}
 }
 
-func (e *Error) ErrorContains(t *testing.T, substr string) {
+func (e *Error) ErrorContains(t TestingT, substr string) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if !strings.Contains(e.msg, substr) {
 		t.Errorf("error '%s' does not contain substring '%s'", e.msg, substr)
 	}
 }
 
-func (e *Error) ErrorNotContains(t *testing.T, substr string) {
+func (e *Error) ErrorNotContains(t TestingT, substr string) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
 	if strings.Contains(e.msg, substr) {
 		t.Errorf("error '%s' does contain substring '%s'", e.msg, substr)
 	}
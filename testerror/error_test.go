@@ -3,12 +3,19 @@ package testerror
 import (
 	"testing"
 
+	tttesting "github.com/gruntwork-io/terratest/modules/testing"
 	"github.com/stretchr/testify/assert"
 )
 
+var (
+	_ TestingT = testing.TB(nil)
+	_ TestingT = tttesting.TestingT(nil)
+)
+
 func TestNewErrorF(t *testing.T) {
 	err := Newf("test %s", "error")
-	assert.Equal(t, "test error", err.Error())
+	assert.Equal(t, "test error", err.Message())
+	assert.Equal(t, err.Location()+": test error", err.Error())
 }
 
 func TestNewAsError(t *testing.T) {
@@ -16,7 +23,7 @@ func TestNewAsError(t *testing.T) {
 		return e.Error()
 	}
 	e := New("test error")
-	assert.Equal(t, "test error", f(e))
+	assert.Regexp(t, `^error_test\.go:\d+: test error$`, f(e))
 }
 
 func TestNewErrorNill(t *testing.T) {
@@ -100,3 +107,43 @@ func TestErrorNotNillFail(t *testing.T) {
 	e.ErrorNotNil(&t1)
 	assert.True(t, t1.Failed())
 }
+
+func TestJoin(t *testing.T) {
+	one, two := New("error one"), New("error two")
+	e := Join("2 errors", []*Error{one, two})
+	assert.Equal(t, "2 errors\n  "+one.Location()+": error one\n  "+two.Location()+": error two", e.Message())
+}
+
+func TestJoinNone(t *testing.T) {
+	assert.Nil(t, Join("no errors", nil))
+}
+
+// mockT implements TestingT without Helper(), like terratest's testing.TestingT.
+type mockT struct {
+	failed bool
+	fatal  bool
+}
+
+func (m *mockT) Error(args ...any)                 { m.failed = true }
+func (m *mockT) Errorf(format string, args ...any) { m.failed = true }
+func (m *mockT) Fatal(args ...any)                 { m.failed, m.fatal = true, true }
+
+func TestErrorIsNilTestingT(t *testing.T) {
+	m := &mockT{}
+	New("test error").ErrorIsNil(m)
+	assert.True(t, m.failed)
+	assert.False(t, m.fatal)
+}
+
+func TestErrorIsNilFatalTestingT(t *testing.T) {
+	m := &mockT{}
+	New("test error").ErrorIsNilFatal(m)
+	assert.True(t, m.fatal)
+}
+
+func BenchmarkErrorIsNil(b *testing.B) {
+	var e *Error
+	for i := 0; i < b.N; i++ {
+		e.ErrorIsNil(b)
+	}
+}
@@ -0,0 +1,48 @@
+package testerror
+
+// Kind classifies an *Error so that failures can be handled programmatically.
+// Kind implements the error interface so that it can be used as the target of errors.Is,
+// e.g. `errors.Is(err, testerror.TerraformCommandFailed)`.
+type Kind int
+
+const (
+	// Unspecified is the Kind of errors that have not been classified.
+	Unspecified Kind = iota
+	// NotFound is the Kind of errors where a resource, key or value does not exist.
+	NotFound
+	// ValueMismatch is the Kind of errors where a value does not meet the expectation of an assertion,
+	// including values that will not be known until after apply.
+	ValueMismatch
+	// TypeMismatch is the Kind of errors where a value is not of the type required by an assertion.
+	TypeMismatch
+	// InvalidJSON is the Kind of errors where a value cannot be decoded as JSON.
+	InvalidJSON
+	// TerraformCommandFailed is the Kind of errors where a Terraform command returns an error.
+	TerraformCommandFailed
+	// NotIdempotent is the Kind of errors where a plan after apply contains changes.
+	NotIdempotent
+)
+
+// String returns the name of the Kind.
+func (k Kind) String() string {
+	switch k {
+	case NotFound:
+		return "not found"
+	case ValueMismatch:
+		return "value mismatch"
+	case TypeMismatch:
+		return "type mismatch"
+	case InvalidJSON:
+		return "invalid JSON"
+	case TerraformCommandFailed:
+		return "terraform command failed"
+	case NotIdempotent:
+		return "not idempotent"
+	}
+	return "unspecified"
+}
+
+// Error implements the error interface.
+func (k Kind) Error() string {
+	return k.String()
+}
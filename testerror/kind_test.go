@@ -0,0 +1,65 @@
+package testerror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKindString(t *testing.T) {
+	assert.Equal(t, "unspecified", Unspecified.String())
+	assert.Equal(t, "not found", NotFound.String())
+	assert.Equal(t, "not idempotent", NotIdempotent.Error())
+}
+
+func TestKindIs(t *testing.T) {
+	e := New("test error").WithKind(NotFound)
+	assert.ErrorIs(t, e, NotFound)
+	assert.NotErrorIs(t, e, ValueMismatch)
+	assert.ErrorIs(t, e.AsError(), NotFound)
+}
+
+func TestKindIsWrapped(t *testing.T) {
+	cause := errors.New("cause")
+	e := New("test error").WithKind(TerraformCommandFailed).Wrap(cause)
+	assert.ErrorIs(t, e, cause)
+	assert.ErrorIs(t, e, TerraformCommandFailed)
+	assert.Equal(t, cause, errors.Unwrap(e))
+	assert.Equal(t, "test error", e.Message())
+}
+
+func TestKindAs(t *testing.T) {
+	err := New("test error").
+		WithKind(ValueMismatch).
+		WithReference("a.b").
+		WithValues("expected", "actual").
+		AsError()
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, ValueMismatch, e.Kind)
+	assert.Equal(t, "a.b", e.Reference)
+	assert.Equal(t, "expected", e.Expected)
+	assert.Equal(t, "actual", e.Actual)
+}
+
+func TestWithCopies(t *testing.T) {
+	e := New("test error")
+	k := e.WithKind(NotFound)
+	assert.Equal(t, Unspecified, e.Kind)
+	assert.Equal(t, NotFound, k.Kind)
+}
+
+func TestWithNil(t *testing.T) {
+	var e *Error
+	assert.Nil(t, e.WithKind(NotFound))
+	assert.Nil(t, e.WithReference("a.b"))
+	assert.Nil(t, e.WithValues(1, 2))
+	assert.Nil(t, e.Wrap(errors.New("cause")))
+}
+
+func TestJoinIs(t *testing.T) {
+	e := Join("summary", []*Error{New("a"), New("b").WithKind(InvalidJSON)})
+	assert.ErrorIs(t, e, InvalidJSON)
+	assert.NotErrorIs(t, e, NotFound)
+}
@@ -0,0 +1,48 @@
+package testerror
+
+import (
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxCallerDepth is the maximum number of stack frames searched for the caller.
+const maxCallerDepth = 32
+
+// modulePath is the import path of this module, whose frames are skipped when finding the caller.
+var modulePath = strings.TrimSuffix(reflect.TypeOf(Error{}).PkgPath(), "/testerror")
+
+// caller returns the file and line of the first frame on the call stack outside of this module,
+// i.e. the user's call site. Test files are not skipped, so that the module's own tests are treated as callers.
+// It returns an empty file if the caller cannot be found, e.g. when the check is run from a test cleanup.
+func caller() (string, int) {
+	pcs := make([]uintptr, maxCallerDepth)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if !isLibraryFrame(f) {
+			if strings.HasPrefix(f.Function, "testing.") || strings.HasPrefix(f.Function, "runtime.") {
+				return "", 0
+			}
+			return f.File, f.Line
+		}
+		if !more {
+			return "", 0
+		}
+	}
+}
+
+func isLibraryFrame(f runtime.Frame) bool {
+	return strings.HasPrefix(f.Function, modulePath+"/") && !strings.HasSuffix(f.File, "_test.go")
+}
+
+// location formats the file and line as `file.go:42`, using the base name of the file as the testing package does.
+func location(file string, line int) string {
+	if file == "" {
+		return ""
+	}
+	return filepath.Base(file) + ":" + strconv.Itoa(line)
+}
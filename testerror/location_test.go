@@ -0,0 +1,43 @@
+package testerror
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocation(t *testing.T) {
+	_, file, line, _ := runtime.Caller(0)
+	e := New("test error")
+	assert.Equal(t, file, e.File)
+	assert.Equal(t, line+1, e.Line)
+	assert.Regexp(t, `^location_test\.go:\d+$`, e.Location())
+}
+
+func TestLocationUnknown(t *testing.T) {
+	e := &Error{msg: "test error"}
+	assert.Equal(t, "", e.Location())
+	assert.Equal(t, "test error", e.Error())
+}
+
+func TestLocationWith(t *testing.T) {
+	e := New("test error")
+	k := e.WithKind(NotFound).WithReference("a.b")
+	assert.Equal(t, e.Location(), k.Location())
+}
+
+func TestIsLibraryFrame(t *testing.T) {
+	assert.True(t, isLibraryFrame(runtime.Frame{
+		Function: modulePath + "/ops.Operative.HasValue",
+		File:     "/src/ops/ops.go",
+	}))
+	assert.False(t, isLibraryFrame(runtime.Frame{
+		Function: modulePath + "/ops.TestHasValue",
+		File:     "/src/ops/ops_test.go",
+	}))
+	assert.False(t, isLibraryFrame(runtime.Frame{
+		Function: "example.com/mymodule.TestModule",
+		File:     "/src/mymodule/main_test.go",
+	}))
+}
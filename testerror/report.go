@@ -0,0 +1,83 @@
+package testerror
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Result is the outcome of a single check, as passed to a Reporter.
+// Expected and Actual are formatted using fmt and are empty if not known.
+// File and Line are the user's call site of the check, if known.
+type Result struct {
+	Check     string        `json:"check"`
+	Reference string        `json:"reference"`
+	Passed    bool          `json:"passed"`
+	Kind      string        `json:"kind,omitempty"`
+	Message   string        `json:"message,omitempty"`
+	Expected  string        `json:"expected,omitempty"`
+	Actual    string        `json:"actual,omitempty"`
+	File      string        `json:"file,omitempty"`
+	Line      int           `json:"line,omitempty"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// Reporter receives the Result of every check made through the check, ops and setuptest packages.
+// Implementations must be safe for concurrent use, as tests may run in parallel.
+type Reporter interface {
+	Record(r Result)
+}
+
+var (
+	reporterMu sync.RWMutex
+	reporter   Reporter
+)
+
+// SetReporter sets the Reporter that receives the Result of every check, use nil to stop reporting.
+// It is typically called from TestMain, see the report package.
+func SetReporter(r Reporter) {
+	reporterMu.Lock()
+	defer reporterMu.Unlock()
+	reporter = r
+}
+
+// Record passes the Result of a check made with the supplied arguments to the Reporter, if one is set.
+// If err is non-nil, its Reference, Expected and Actual fields take precedence over the arguments.
+// It is intended to be deferred by each check, so that err holds the outcome.
+func Record(check, reference string, expected, actual any, start time.Time, err *Error) {
+	reporterMu.RLock()
+	r := reporter
+	reporterMu.RUnlock()
+	if r == nil {
+		return
+	}
+	file, line := caller()
+	res := Result{
+		Check:     check,
+		Reference: reference,
+		Passed:    err == nil,
+		File:      file,
+		Line:      line,
+		Duration:  time.Since(start),
+	}
+	if err != nil {
+		res.Kind = err.Kind.String()
+		res.Message = err.msg
+		if err.Reference != "" {
+			res.Reference = err.Reference
+		}
+		if res.File == "" {
+			res.File, res.Line = err.File, err.Line
+		}
+		if err.Expected != nil || err.Actual != nil {
+			expected, actual = err.Expected, err.Actual
+		}
+	}
+	if expected != nil {
+		res.Expected = fmt.Sprint(expected)
+	}
+	if actual != nil {
+		res.Actual = fmt.Sprint(actual)
+	}
+	r.Record(res)
+}
@@ -0,0 +1,66 @@
+package testerror
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReporter struct {
+	mu      sync.Mutex
+	results []Result
+}
+
+func (m *mockReporter) Record(r Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.results = append(m.results, r)
+}
+
+// TestRecord is not run in parallel, as the reporter is global.
+func TestRecord(t *testing.T) {
+	m := &mockReporter{}
+	SetReporter(m)
+	defer SetReporter(nil)
+
+	Record("HasValue", "a.b", "x", "x", time.Now(), nil)
+	Record("HasValue", "a.b", "x", "y", time.Now(), New("a.b.c: test error").
+		WithKind(ValueMismatch).
+		WithReference("a.b.c").
+		WithValues("z", "y"))
+
+	require.Len(t, m.results, 2)
+	for _, r := range m.results {
+		assert.Equal(t, "report_test.go", filepath.Base(r.File))
+		assert.NotZero(t, r.Line)
+	}
+	assert.Equal(t, Result{
+		Check:     "HasValue",
+		Reference: "a.b",
+		Passed:    true,
+		Expected:  "x",
+		Actual:    "x",
+		File:      m.results[0].File,
+		Line:      m.results[0].Line,
+		Duration:  m.results[0].Duration,
+	}, m.results[0])
+	assert.Equal(t, Result{
+		Check:     "HasValue",
+		Reference: "a.b.c",
+		Kind:      "value mismatch",
+		Message:   "a.b.c: test error",
+		Expected:  "z",
+		Actual:    "y",
+		File:      m.results[1].File,
+		Line:      m.results[1].Line,
+		Duration:  m.results[1].Duration,
+	}, m.results[1])
+}
+
+func TestRecordNoReporter(t *testing.T) {
+	Record("HasValue", "a.b", "x", "x", time.Now(), nil)
+}